@@ -0,0 +1,2 @@
+# ACLs can be imported by specifying the virtual cluster ID. Every ACL of the virtual cluster is imported.
+terraform import warpstream_acls.example vci_XXXXXXXXXX
@@ -0,0 +1,34 @@
+resource "warpstream_virtual_cluster" "acl_example" {
+  name = "vcn_example_acls"
+  tier = "dev"
+  configuration = {
+    enable_acls = true
+  }
+}
+
+# Every ACL of the virtual cluster that isn't listed here is deleted.
+resource "warpstream_acls" "all" {
+  virtual_cluster_id = warpstream_virtual_cluster.acl_example.id
+  exclusive          = true
+
+  acls = [
+    {
+      host            = "*"
+      principal       = "User:orders-service"
+      operation       = "WRITE"
+      permission_type = "ALLOW"
+      resource_type   = "TOPIC"
+      resource_name   = "orders"
+      pattern_type    = "LITERAL"
+    },
+    {
+      host            = "*"
+      principal       = "User:analytics"
+      operation       = "READ"
+      permission_type = "ALLOW"
+      resource_type   = "TOPIC"
+      resource_name   = "orders"
+      pattern_type    = "LITERAL"
+    },
+  ]
+}
@@ -0,0 +1,2 @@
+# Topics can be imported by specifying the virtual cluster ID and a comma-separated list of topic names.
+terraform import warpstream_topics.example vci_XXXXXXXXXX/orders,payments
@@ -0,0 +1,26 @@
+resource "warpstream_virtual_cluster" "test" {
+  name = "vcn_test"
+  tier = "dev"
+}
+
+resource "warpstream_topics" "topics" {
+  virtual_cluster_id = warpstream_virtual_cluster.test.id
+
+  config {
+    name  = "retention.ms"
+    value = "604800000"
+  }
+
+  topics = {
+    orders = {
+      partition_count = 12
+    }
+    audit = {
+      partition_count = 1
+      config = [{
+        name  = "retention.ms"
+        value = "2592000000"
+      }]
+    }
+  }
+}
@@ -2,11 +2,13 @@ package api
 
 import (
 	"bytes"
+	"context"
 	"crypto/sha256"
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"slices"
 	"sync"
 )
 
@@ -97,13 +99,13 @@ type ACLDeleteResponse struct {
 }
 
 // CreateACL creates a new ACL in the specified virtual cluster.
-func (c *Client) CreateACL(vcID string, acl ACLRequest) (*ACLResponse, error) {
+func (c *Client) CreateACL(ctx context.Context, vcID string, acl ACLRequest) (*ACLResponse, error) {
 	payload, err := json.Marshal(ACLCreateRequest{VirtualClusterID: vcID, ACL: acl})
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("%s/virtual_clusters/acls/create", c.HostURL), bytes.NewReader(payload))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/virtual_clusters/acls/create", c.HostURL), bytes.NewReader(payload))
 	if err != nil {
 		return nil, err
 	}
@@ -125,7 +127,7 @@ func (c *Client) CreateACL(vcID string, acl ACLRequest) (*ACLResponse, error) {
 }
 
 // GetACL retrieves a specific ACL by its ID within the specified virtual cluster.
-func (c *Client) GetACL(vcID string, targetACL ACLRequest) (*ACLResponse, error) {
+func (c *Client) GetACL(ctx context.Context, vcID string, targetACL ACLRequest) (*ACLResponse, error) {
 	acl, cached, found := c.aclsCache.getACL(vcID, targetACL)
 	if cached {
 		if !found {
@@ -135,7 +137,7 @@ func (c *Client) GetACL(vcID string, targetACL ACLRequest) (*ACLResponse, error)
 		return &acl, nil
 	}
 
-	acls, err := c.ListACLs(vcID)
+	acls, err := c.ListACLs(ctx, vcID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list ACLs: %w", err)
 	}
@@ -149,7 +151,7 @@ func (c *Client) GetACL(vcID string, targetACL ACLRequest) (*ACLResponse, error)
 }
 
 // ListACLs retrieves all ACLs for a given virtual cluster.
-func (c *Client) ListACLs(vcID string) ([]ACLResponse, error) {
+func (c *Client) ListACLs(ctx context.Context, vcID string) ([]ACLResponse, error) {
 	if acls, ok := c.aclsCache.get(vcID); ok {
 		return acls, nil
 	}
@@ -159,7 +161,7 @@ func (c *Client) ListACLs(vcID string) ([]ACLResponse, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("%s/virtual_clusters/acls/list", c.HostURL), bytes.NewReader(payload))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/virtual_clusters/acls/list", c.HostURL), bytes.NewReader(payload))
 	if err != nil {
 		return nil, err
 	}
@@ -180,37 +182,64 @@ func (c *Client) ListACLs(vcID string) ([]ACLResponse, error) {
 }
 
 // DeleteACL deletes an ACL by its ID within the specified virtual cluster.
-func (c *Client) DeleteACL(vcID string, acl ACLRequest) error {
-	payload, err := json.Marshal(ACLDeleteRequest{VirtualClusterID: vcID, ACLs: []ACLRequest{acl}})
+func (c *Client) DeleteACL(ctx context.Context, vcID string, acl ACLRequest) error {
+	deleted, err := c.deleteACLs(ctx, vcID, []ACLRequest{acl})
 	if err != nil {
 		return err
 	}
 
-	req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("%s/virtual_clusters/acls/delete", c.HostURL), bytes.NewReader(payload))
+	// assert that one ACL was deleted
+	if len(deleted) != 1 {
+		return fmt.Errorf("expected 1 ACL to be deleted, got %d", len(deleted))
+	}
+
+	return nil
+}
+
+// aclDeleteBatchSize is the most ACLs DeleteACLs sends in a single request.
+const aclDeleteBatchSize = 100
+
+// DeleteACLs deletes many ACLs within the specified virtual cluster, a batch at a time, and
+// returns the ones that were deleted. ACLs that no longer exist are skipped.
+func (c *Client) DeleteACLs(ctx context.Context, vcID string, acls []ACLRequest) ([]ACLResponse, error) {
+	var deleted []ACLResponse
+	for batch := range slices.Chunk(acls, aclDeleteBatchSize) {
+		batchDeleted, err := c.deleteACLs(ctx, vcID, batch)
+		deleted = append(deleted, batchDeleted...)
+		if err != nil {
+			return deleted, err
+		}
+	}
+
+	return deleted, nil
+}
+
+func (c *Client) deleteACLs(ctx context.Context, vcID string, acls []ACLRequest) ([]ACLResponse, error) {
+	payload, err := json.Marshal(ACLDeleteRequest{VirtualClusterID: vcID, ACLs: acls})
 	if err != nil {
-		return err
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/virtual_clusters/acls/delete", c.HostURL), bytes.NewReader(payload))
+	if err != nil {
+		return nil, err
 	}
 	req.Header.Set("Content-Type", "application/json")
 
 	body, err := c.doRequest(req, nil)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	var deleteResp ACLDeleteResponse
 	err = json.Unmarshal(body, &deleteResp)
 	if err != nil {
-		return err
-	}
-
-	// assert that one ACL was deleted
-	if len(deleteResp.ACLs) != 1 {
-		return fmt.Errorf("expected 1 ACL to be deleted, got %d", len(deleteResp.ACLs))
+		return nil, err
 	}
 
 	c.aclsCache.invalidate(vcID)
 
-	return nil
+	return deleteResp.ACLs, nil
 }
 
 // aclsEqual returns true if all identifying fields of two ACLs are equal.
@@ -2,6 +2,7 @@ package api
 
 import (
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"reflect"
@@ -23,17 +24,17 @@ func TestClientListACLsCachesPerVirtualCluster(t *testing.T) {
 
 	client := newACLTestClient(t, server.URL)
 
-	first, err := client.ListACLs("vc-1")
+	first, err := client.ListACLs(t.Context(), "vc-1")
 	if err != nil {
 		t.Fatalf("ListACLs(vc-1) returned error: %v", err)
 	}
 
-	second, err := client.ListACLs("vc-1")
+	second, err := client.ListACLs(t.Context(), "vc-1")
 	if err != nil {
 		t.Fatalf("ListACLs(vc-1) cached call returned error: %v", err)
 	}
 
-	other, err := client.ListACLs("vc-2")
+	other, err := client.ListACLs(t.Context(), "vc-2")
 	if err != nil {
 		t.Fatalf("ListACLs(vc-2) returned error: %v", err)
 	}
@@ -74,7 +75,7 @@ func TestClientGetACLUsesCacheIndex(t *testing.T) {
 
 	client := newACLTestClient(t, server.URL)
 
-	if _, err := client.ListACLs("vc-1"); err != nil {
+	if _, err := client.ListACLs(t.Context(), "vc-1"); err != nil {
 		t.Fatalf("ListACLs returned error: %v", err)
 	}
 
@@ -99,7 +100,7 @@ func TestClientGetACLUsesCacheIndex(t *testing.T) {
 		t.Fatalf("expected indexed ACL to match target ACL, got %v", indexedACL)
 	}
 
-	gotACL, err := client.GetACL("vc-1", ACLRequest(targetACL))
+	gotACL, err := client.GetACL(t.Context(), "vc-1", ACLRequest(targetACL))
 	if err != nil {
 		t.Fatalf("GetACL returned error: %v", err)
 	}
@@ -165,7 +166,7 @@ func TestClientGetACLUsesListSnapshotAfterCacheInvalidation(t *testing.T) {
 
 	resultCh := make(chan getACLResult, 1)
 	go func() {
-		acl, err := client.GetACL("vc-1", ACLRequest(targetACL))
+		acl, err := client.GetACL(t.Context(), "vc-1", ACLRequest(targetACL))
 		resultCh <- getACLResult{acl: acl, err: err}
 	}()
 
@@ -230,19 +231,19 @@ func TestClientCreateAndDeleteACLInvalidateCache(t *testing.T) {
 
 	client := newACLTestClient(t, server.URL)
 
-	if _, err := client.ListACLs("vc-1"); err != nil {
+	if _, err := client.ListACLs(t.Context(), "vc-1"); err != nil {
 		t.Fatalf("initial ListACLs returned error: %v", err)
 	}
 
-	if _, err := client.ListACLs("vc-1"); err != nil {
+	if _, err := client.ListACLs(t.Context(), "vc-1"); err != nil {
 		t.Fatalf("cached ListACLs returned error: %v", err)
 	}
 
-	if _, err := client.CreateACL("vc-1", createdACL); err != nil {
+	if _, err := client.CreateACL(t.Context(), "vc-1", createdACL); err != nil {
 		t.Fatalf("CreateACL returned error: %v", err)
 	}
 
-	aclsAfterCreate, err := client.ListACLs("vc-1")
+	aclsAfterCreate, err := client.ListACLs(t.Context(), "vc-1")
 	if err != nil {
 		t.Fatalf("ListACLs after CreateACL returned error: %v", err)
 	}
@@ -255,11 +256,11 @@ func TestClientCreateAndDeleteACLInvalidateCache(t *testing.T) {
 		t.Fatalf("expected created ACL to be returned after cache invalidation, got %v", aclsAfterCreate)
 	}
 
-	if err := client.DeleteACL("vc-1", ACLRequest(existingACL)); err != nil {
+	if err := client.DeleteACL(t.Context(), "vc-1", ACLRequest(existingACL)); err != nil {
 		t.Fatalf("DeleteACL returned error: %v", err)
 	}
 
-	aclsAfterDelete, err := client.ListACLs("vc-1")
+	aclsAfterDelete, err := client.ListACLs(t.Context(), "vc-1")
 	if err != nil {
 		t.Fatalf("ListACLs after DeleteACL returned error: %v", err)
 	}
@@ -288,6 +289,55 @@ func TestClientCreateAndDeleteACLInvalidateCache(t *testing.T) {
 	}
 }
 
+func TestClientDeleteACLsBatches(t *testing.T) {
+	t.Parallel()
+
+	var existing []ACLResponse
+	for i := range aclDeleteBatchSize + 1 {
+		existing = append(existing, testACLResponse(fmt.Sprintf("topic-%d", i), "User:alice", "READ"))
+	}
+	kept := testACLResponse("orders", "User:bob", "READ")
+
+	state := newACLTestServerState(map[string][]ACLResponse{
+		"vc-1": append(cloneACLResponses(existing), kept),
+	})
+
+	server := newACLTestServer(state)
+	defer server.Close()
+
+	client := newACLTestClient(t, server.URL)
+
+	toDelete := make([]ACLRequest, 0, len(existing)+1)
+	for _, acl := range existing {
+		toDelete = append(toDelete, ACLRequest(acl))
+	}
+	// An ACL that is already gone is skipped rather than failing the batch.
+	toDelete = append(toDelete, testACLRequest("missing", "User:alice", "READ"))
+
+	deleted, err := client.DeleteACLs(t.Context(), "vc-1", toDelete)
+	if err != nil {
+		t.Fatalf("DeleteACLs returned error: %v", err)
+	}
+	if len(deleted) != len(existing) {
+		t.Fatalf("expected %d ACLs to be deleted, got %d", len(existing), len(deleted))
+	}
+
+	remaining, err := client.ListACLs(t.Context(), "vc-1")
+	if err != nil {
+		t.Fatalf("ListACLs after DeleteACLs returned error: %v", err)
+	}
+	if !reflect.DeepEqual(remaining, []ACLResponse{kept}) {
+		t.Fatalf("expected only %v to remain, got %v", kept, remaining)
+	}
+
+	state.mu.Lock()
+	defer state.mu.Unlock()
+
+	if got := state.deleteCalls["vc-1"]; got != 2 {
+		t.Fatalf("expected 2 batched delete calls, got %d", got)
+	}
+}
+
 type aclTestServerState struct {
 	mu          sync.Mutex
 	aclsByVC    map[string][]ACLResponse
@@ -384,13 +434,7 @@ func newACLTestServer(state *aclTestServerState) *httptest.Server {
 func newACLTestClient(t *testing.T, host string) *Client {
 	t.Helper()
 
-	token := "test-token"
-	client, err := NewClient(host, &token, "test")
-	if err != nil {
-		t.Fatalf("NewClient returned error: %v", err)
-	}
-
-	return client
+	return newTestClientWithOptions(t, host, DefaultClientOptions())
 }
 
 func testACLRequest(resourceName, principal, operation string) ACLRequest {
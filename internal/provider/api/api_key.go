@@ -2,6 +2,7 @@ package api
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -81,7 +82,7 @@ type APIKeyDeleteRequest struct {
 }
 
 // CreateAgentKey - Create new Agent Key. Supports creating keys with just one access grant for now.
-func (c *Client) CreateAgentKey(name, virtualClusterID string, readOnly bool) (*APIKey, error) {
+func (c *Client) CreateAgentKey(ctx context.Context, name, virtualClusterID string, readOnly bool) (*APIKey, error) {
 	virtualClusterTypeOverride := ""
 	if strings.HasPrefix(virtualClusterID, "vci_sr_") {
 		virtualClusterTypeOverride = VirtualClusterTypeSchemaRegistry
@@ -100,10 +101,10 @@ func (c *Client) CreateAgentKey(name, virtualClusterID string, readOnly bool) (*
 		"resource_id":    virtualClusterID,
 	}
 
-	return c.createAPIKey(name, accessGrant, virtualClusterTypeOverride)
+	return c.createAPIKey(ctx, name, accessGrant, virtualClusterTypeOverride)
 }
 
-func (c *Client) CreateApplicationKey(name, workspaceID string, readOnly bool) (*APIKey, error) {
+func (c *Client) CreateApplicationKey(ctx context.Context, name, workspaceID string, readOnly bool) (*APIKey, error) {
 	principalKind := PrincipalKindApplication
 	if readOnly {
 		principalKind = PrincipalKindApplicationReadOnly
@@ -116,10 +117,34 @@ func (c *Client) CreateApplicationKey(name, workspaceID string, readOnly bool) (
 		"workspace_id":   workspaceID, // Can be empty.
 	}
 
-	return c.createAPIKey(name, accessGrant, "")
+	return c.createAPIKey(ctx, name, accessGrant, "")
 }
 
+// createAPIKey creates an API key. The key's secret is only returned on creation, so if an
+// ambiguous failure left keys behind they are deleted and the key is created again rather than
+// reused. Keys that had the name before the create are never deleted.
 func (c *Client) createAPIKey(
+	ctx context.Context,
+	name string,
+	accessGrant map[string]string,
+	virtualClusterTypeOverride string,
+) (*APIKey, error) {
+	name = "akn_" + strings.TrimPrefix(name, "akn_")
+	existing := snapshotIDs(ctx, func(ctx context.Context) ([]string, error) {
+		return c.apiKeyIDsNamed(ctx, name)
+	})
+	return createIdempotently(ctx,
+		func(ctx context.Context) (*APIKey, error) {
+			return c.sendCreateAPIKey(ctx, name, accessGrant, virtualClusterTypeOverride)
+		},
+		func(ctx context.Context) (*APIKey, bool, error) {
+			return nil, false, c.deleteOrphanedAPIKeys(ctx, name, existing)
+		},
+	)
+}
+
+func (c *Client) sendCreateAPIKey(
+	ctx context.Context,
 	name string,
 	accessGrant map[string]string,
 	virtualClusterTypeOverride string,
@@ -133,12 +158,14 @@ func (c *Client) createAPIKey(
 		return nil, err
 	}
 
-	req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("%s/create_api_key", c.HostURL), bytes.NewReader(payload))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/create_api_key", c.HostURL), bytes.NewReader(payload))
 	if err != nil {
 		return nil, err
 	}
 
 	body, err := c.doRequest(req, nil)
+	// Agent keys are listed in the virtual cluster describe.
+	c.describeCache.invalidateAll()
 	if err != nil {
 		return nil, err
 	}
@@ -152,19 +179,58 @@ func (c *Client) createAPIKey(
 	return &res, nil
 }
 
+// apiKeyIDsNamed returns the IDs of the API keys with the given name.
+func (c *Client) apiKeyIDsNamed(ctx context.Context, name string) ([]string, error) {
+	keys, err := c.GetAPIKeys(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []string
+	for _, key := range keys {
+		if key.Name == name {
+			ids = append(ids, key.ID)
+		}
+	}
+	return ids, nil
+}
+
+// deleteOrphanedAPIKeys deletes the API keys with the given name that aren't in existing, which
+// were left behind by an ambiguous create.
+func (c *Client) deleteOrphanedAPIKeys(ctx context.Context, name string, existing map[string]bool) error {
+	if existing == nil {
+		return errNoSnapshot
+	}
+
+	ids, err := c.apiKeyIDsNamed(ctx, name)
+	if err != nil {
+		return err
+	}
+	for _, id := range ids {
+		if existing[id] {
+			continue
+		}
+		if err := c.DeleteAPIKey(ctx, id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // DeleteAPIKey - Delete an API Key.
-func (c *Client) DeleteAPIKey(id string) error {
+func (c *Client) DeleteAPIKey(ctx context.Context, id string) error {
 	payload, err := json.Marshal(APIKeyDeleteRequest{ID: id})
 	if err != nil {
 		return err
 	}
 
-	req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("%s/delete_api_key", c.HostURL), bytes.NewReader(payload))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/delete_api_key", c.HostURL), bytes.NewReader(payload))
 	if err != nil {
 		return err
 	}
 
 	body, err := c.doRequest(req, nil)
+	c.describeCache.invalidateAll()
 	if err != nil {
 		return err
 	}
@@ -177,8 +243,8 @@ func (c *Client) DeleteAPIKey(id string) error {
 }
 
 // GetAPIKeys - Returns list of API keys.
-func (c *Client) GetAPIKeys() ([]APIKey, error) {
-	req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("%s/list_api_keys", c.HostURL), nil)
+func (c *Client) GetAPIKeys(ctx context.Context) ([]APIKey, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/list_api_keys", c.HostURL), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -198,8 +264,8 @@ func (c *Client) GetAPIKeys() ([]APIKey, error) {
 }
 
 // GetAPIKey - Returns one API key.
-func (c *Client) GetAPIKey(apiKeyID string) (*APIKey, error) {
-	keys, err := c.GetAPIKeys()
+func (c *Client) GetAPIKey(ctx context.Context, apiKeyID string) (*APIKey, error) {
+	keys, err := c.GetAPIKeys(ctx)
 
 	if err != nil {
 		return nil, fmt.Errorf("failed to get API keys list: %w", err)
@@ -1,18 +1,20 @@
 package api
 
 import (
+	"bytes"
 	"context"
+	"crypto/tls"
 	"errors"
 	"fmt"
 	"io"
-	"log"
 	"net/http"
-	"net/http/httputil"
+	"net/url"
 	"os"
 	"strings"
 	"time"
 
 	"github.com/hashicorp/go-retryablehttp"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 var ErrNotFound = errors.New("resource not found")
@@ -23,22 +25,96 @@ const HostURL string = "https://api.prod.us-east-1.warpstream.com/api/v1"
 // devVersion is used in the User-Agent for local builds and tests.
 const devVersion string = "dev"
 
+// ClientOptions controls how the client retries and times out API calls.
+type ClientOptions struct {
+	// MaxRetries is the number of times a failed request is retried before giving up.
+	MaxRetries int
+	// RetryWaitMin and RetryWaitMax bound the exponential backoff between retries.
+	RetryWaitMin time.Duration
+	RetryWaitMax time.Duration
+	// RequestTimeout caps a single HTTP attempt, not counting time spent waiting on the rate and
+	// concurrency limits or a Retry-After pause.
+	RequestTimeout time.Duration
+	// CallTimeout caps the total time spent on a single API call, retries included.
+	CallTimeout time.Duration
+	// RequestsPerSecond is the steady rate of requests the client sends. Zero means unlimited.
+	RequestsPerSecond float64
+	// RateLimitBurst is the number of requests that may be sent at once above RequestsPerSecond.
+	// Zero picks a burst matching RequestsPerSecond.
+	RateLimitBurst int
+	// MaxInFlight caps the number of requests awaiting a response at any time. Zero means unlimited.
+	MaxInFlight int
+	// TLSConfig, when set, replaces the TLS settings of the client's transport, e.g. to trust a
+	// custom CA or present a client certificate.
+	TLSConfig *tls.Config
+	// ProxyURL, when set, sends every request through that proxy instead of the one named by the
+	// HTTP_PROXY, HTTPS_PROXY and NO_PROXY environment variables.
+	ProxyURL *url.URL
+	// WrapTransport, when set, wraps the transport each HTTP attempt goes through, beneath retries
+	// and rate limiting. Tests use it to record and replay API traffic.
+	WrapTransport func(http.RoundTripper) http.RoundTripper
+}
+
+// DefaultClientOptions returns the options used when the provider configuration doesn't override them.
+func DefaultClientOptions() ClientOptions {
+	return ClientOptions{
+		MaxRetries:     5,
+		RetryWaitMin:   1 * time.Second,
+		RetryWaitMax:   30 * time.Second,
+		RequestTimeout: 30 * time.Second,
+		CallTimeout:    4 * time.Minute,
+	}
+}
+
 // Client.
 type Client struct {
-	HostURL    string
-	HTTPClient *retryablehttp.Client
-	Token      string
-	UserAgent  string
-	aclsCache  aclsCache
+	HostURL     string
+	HTTPClient  *retryablehttp.Client
+	Token       string
+	UserAgent   string
+	callTimeout time.Duration
+	aclsCache   aclsCache
+	topicsCache topicsCache
+
+	describeCache describeCache
+
+	// WorkspaceID is the workspace the provider's profile points at, if any. Resources that take an
+	// optional workspace default to it.
+	WorkspaceID string
+	// Region is the control plane region chosen with the provider's region attribute, and is empty
+	// when the attribute is not set, even if the base URL points at a regional control plane.
+	// When set, managed clusters must be in this region.
+	Region string
+	// DefaultTags are the tags from the provider's default_tags block. Virtual clusters carry them in
+	// addition to their own tags, which win on conflicting keys.
+	DefaultTags map[string]string
+	// DefaultVirtualClusterID is the provider's default_virtual_cluster_id, if any. Cluster-scoped
+	// resources that leave out virtual_cluster_id use it.
+	DefaultVirtualClusterID string
+	// IgnoreTags are the tags from the provider's ignore_tags block. They are left out of state and
+	// kept as they are when a virtual cluster's tags are updated.
+	IgnoreTags IgnoreTags
+	// ReadOnly makes the client refuse every call that would change something. It is set by the
+	// provider's read_only attribute.
+	ReadOnly bool
+	// AllowedWorkspaceIDs and ForbiddenWorkspaceIDs are the provider's allowed_workspace_ids and
+	// forbidden_workspace_ids. Resources in a workspace they rule out fail to plan.
+	AllowedWorkspaceIDs   []string
+	ForbiddenWorkspaceIDs []string
+	// Identity is the kind of API key the provider authenticates with, as found by Identify when the
+	// provider is configured.
+	Identity KeyIdentity
 }
 
 // NewClient.
-func NewClient(host string, token *string, version string) (*Client, error) {
+func NewClient(host string, token *string, version string, opts ClientOptions) (*Client, error) {
 	if version == "" {
 		version = "unset" // should never happen
 	}
 	retryClient := retryablehttp.NewClient()
-	retryClient.RetryMax = 5
+	retryClient.RetryMax = opts.MaxRetries
+	retryClient.RetryWaitMin = opts.RetryWaitMin
+	retryClient.RetryWaitMax = opts.RetryWaitMax
 	retryClient.ErrorHandler = func(resp *http.Response, err error, numTries int) (*http.Response, error) {
 		var (
 			method string
@@ -55,13 +131,34 @@ func NewClient(host string, token *string, version string) (*Client, error) {
 		}
 		return resp, err
 	}
-	retryClient.HTTPClient.Timeout = 30 * time.Second
+	transport := retryClient.HTTPClient.Transport
+	if opts.TLSConfig != nil || opts.ProxyURL != nil {
+		base, ok := transport.(*http.Transport)
+		if !ok {
+			return nil, fmt.Errorf("unexpected HTTP transport %T", transport)
+		}
+		base = base.Clone()
+		if opts.TLSConfig != nil {
+			base.TLSClientConfig = opts.TLSConfig
+		}
+		if opts.ProxyURL != nil {
+			base.Proxy = http.ProxyURL(opts.ProxyURL)
+		}
+		transport = base
+	}
+	if opts.WrapTransport != nil {
+		transport = opts.WrapTransport(transport)
+	}
+	retryClient.HTTPClient.Transport = newLimitedTransport(transport, opts)
 	retryClient.CheckRetry = checkRetryPolicy
+	retryClient.Backoff = retryAfterBackoff
+	retryClient.RequestLogHook = recordAttempt
 	c := Client{
 		HTTPClient: retryClient,
 		// Default Warpstream URL
-		HostURL:   HostURL,
-		UserAgent: fmt.Sprintf("terraform-provider-warpstream/%s", version),
+		HostURL:     HostURL,
+		UserAgent:   fmt.Sprintf("terraform-provider-warpstream/%s", version),
+		callTimeout: opts.CallTimeout,
 	}
 
 	if host != "" {
@@ -95,12 +192,48 @@ func checkRetryPolicy(ctx context.Context, resp *http.Response, err error) (bool
 	return false, checkErr
 }
 
+// retryAfterBackoff waits for as long as the API asks in the Retry-After header of a 429 or 503
+// response, even past the configured maximum backoff, and otherwise backs off exponentially.
+func retryAfterBackoff(minWait, maxWait time.Duration, attemptNum int, resp *http.Response) time.Duration {
+	if resp != nil && (resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode == http.StatusServiceUnavailable) {
+		if wait, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+			return wait
+		}
+	}
+	return retryablehttp.DefaultBackoff(minWait, maxWait, attemptNum, resp)
+}
+
 func (c *Client) doRequest(req *http.Request, authToken *string) ([]byte, error) {
-	d, err := httputil.DumpRequest(req, true)
-	if err != nil {
-		return nil, fmt.Errorf("internal client error: %s", err)
+	if err := c.checkReadOnly(req.URL.Path); err != nil {
+		return nil, err
+	}
+
+	create := idempotentCreateFrom(req.Context())
+	if create != nil {
+		req.Header.Set(IdempotencyKeyHeader, create.key)
+	}
+
+	body, err := c.sendRequest(req, authToken)
+	if err != nil && create != nil && create.ambiguous(err) {
+		return nil, &ambiguousCreateError{err: err}
+	}
+	return body, err
+}
+
+func (c *Client) sendRequest(req *http.Request, authToken *string) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(req.Context(), c.callTimeout)
+	defer cancel()
+	req = req.WithContext(ctx)
+
+	var reqBody []byte
+	if req.Body != nil {
+		var err error
+		reqBody, err = io.ReadAll(req.Body)
+		if err != nil {
+			return nil, fmt.Errorf("internal client error: %s", err)
+		}
+		req.Body = io.NopCloser(bytes.NewReader(reqBody))
 	}
-	log.Printf("%q\n", d)
 
 	token := c.Token
 
@@ -117,15 +250,30 @@ func (c *Client) doRequest(req *http.Request, authToken *string) ([]byte, error)
 		return nil, err
 	}
 
+	logCtx := newLogContext(ctx)
+	logFields := map[string]any{
+		"http_method":     req.Method,
+		"http_path":       req.URL.Path,
+		"request_headers": RedactHeaders(req.Header),
+		"request_body":    lazyRedactedBody{reqBody},
+	}
+
+	start := time.Now()
 	res, err := c.HTTPClient.Do(retryReq)
+	logFields["latency_ms"] = time.Since(start).Milliseconds()
 	if err != nil {
+		logFields["error"] = err.Error()
 		if res != nil {
 			defer res.Body.Close()
+			logFields["http_status"] = res.StatusCode
 			body, readErr := io.ReadAll(res.Body)
 			if readErr == nil {
-				return nil, fmt.Errorf("%w: status: %d, body: %s", err, res.StatusCode, body)
+				logFields["response_body"] = lazyRedactedBody{body}
+				tflog.SubsystemDebug(logCtx, logSubsystem, "WarpStream API request failed", logFields)
+				return nil, fmt.Errorf("%w: %w", err, newAPIError(res, body))
 			}
 		}
+		tflog.SubsystemDebug(logCtx, logSubsystem, "WarpStream API request failed", logFields)
 		return nil, err
 	}
 	defer res.Body.Close()
@@ -134,26 +282,20 @@ func (c *Client) doRequest(req *http.Request, authToken *string) ([]byte, error)
 	if err != nil {
 		return nil, err
 	}
-	log.Printf("%q\n", body)
-
-	if res.StatusCode == http.StatusNotFound {
-		return nil, ErrNotFound
-	}
-
-	if res.StatusCode == http.StatusUnauthorized {
-		errMsg := fmt.Sprintf("status: 401, body: %s", body)
-		if strings.Contains(string(body), "invalid_api_key") {
-			errMsg = fmt.Sprintf("%s\n\n Did you pass an authentication token to the provider?", errMsg)
-		}
-		return nil, errors.New(errMsg)
-	}
+	logFields["http_status"] = res.StatusCode
+	logFields["response_body"] = lazyRedactedBody{body}
+	tflog.SubsystemDebug(logCtx, logSubsystem, "WarpStream API request", logFields)
 
 	if res.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("status: %d, body: %s", res.StatusCode, body)
+		return nil, newAPIError(res, body)
 	}
 
 	if strings.Contains(string(body), "internal server error") {
-		return nil, fmt.Errorf("status: 500, body: internal server error")
+		apiErr := newAPIError(res, body)
+		apiErr.StatusCode = http.StatusInternalServerError
+		apiErr.Code = ""
+		apiErr.Message = "internal server error"
+		return nil, apiErr
 	}
 
 	return body, err
@@ -163,5 +305,5 @@ func NewClientDefault() (*Client, error) {
 	token := os.Getenv("WARPSTREAM_API_KEY")
 	host := os.Getenv("WARPSTREAM_API_URL")
 
-	return NewClient(host, &token, devVersion)
+	return NewClient(host, &token, devVersion, DefaultClientOptions())
 }
@@ -2,6 +2,7 @@ package api
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -57,7 +58,7 @@ type ClientMetricsSubscriptionsDeleteRequest struct {
 
 // ListClientMetricsSubscriptions lists all KIP-714 client metrics
 // subscriptions for the given virtual cluster.
-func (c *Client) ListClientMetricsSubscriptions(virtualClusterID string) ([]ClientMetricsSubscription, error) {
+func (c *Client) ListClientMetricsSubscriptions(ctx context.Context, virtualClusterID string) ([]ClientMetricsSubscription, error) {
 	payload, err := json.Marshal(ClientMetricsSubscriptionListRequest{
 		VirtualClusterID: virtualClusterID,
 	})
@@ -65,7 +66,7 @@ func (c *Client) ListClientMetricsSubscriptions(virtualClusterID string) ([]Clie
 		return nil, err
 	}
 
-	req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("%s/list_client_metrics_subscriptions", c.HostURL), bytes.NewReader(payload))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/list_client_metrics_subscriptions", c.HostURL), bytes.NewReader(payload))
 	if err != nil {
 		return nil, err
 	}
@@ -84,7 +85,7 @@ func (c *Client) ListClientMetricsSubscriptions(virtualClusterID string) ([]Clie
 
 // DescribeClientMetricsSubscription returns the named subscription, or
 // ErrNotFound if it does not exist.
-func (c *Client) DescribeClientMetricsSubscription(virtualClusterID string, name string) (*ClientMetricsSubscription, error) {
+func (c *Client) DescribeClientMetricsSubscription(ctx context.Context, virtualClusterID string, name string) (*ClientMetricsSubscription, error) {
 	payload, err := json.Marshal(ClientMetricsSubscriptionDescribeRequest{
 		VirtualClusterID: virtualClusterID,
 		Name:             name,
@@ -93,7 +94,7 @@ func (c *Client) DescribeClientMetricsSubscription(virtualClusterID string, name
 		return nil, err
 	}
 
-	req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("%s/describe_client_metrics_subscription", c.HostURL), bytes.NewReader(payload))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/describe_client_metrics_subscription", c.HostURL), bytes.NewReader(payload))
 	if err != nil {
 		return nil, err
 	}
@@ -116,7 +117,7 @@ func (c *Client) DescribeClientMetricsSubscription(virtualClusterID string, name
 // rejects duplicate names in the batch and validates every entry before
 // persisting anything; if any entry fails validation, nothing is written.
 // An empty batch is a no-op.
-func (c *Client) UpdateClientMetricsSubscriptions(virtualClusterID string, subscriptions []ClientMetricsSubscription) error {
+func (c *Client) UpdateClientMetricsSubscriptions(ctx context.Context, virtualClusterID string, subscriptions []ClientMetricsSubscription) error {
 	payload, err := json.Marshal(ClientMetricsSubscriptionsUpdateRequest{
 		VirtualClusterID:           virtualClusterID,
 		ClientMetricsSubscriptions: subscriptions,
@@ -125,7 +126,7 @@ func (c *Client) UpdateClientMetricsSubscriptions(virtualClusterID string, subsc
 		return err
 	}
 
-	req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("%s/update_client_metrics_subscriptions", c.HostURL), bytes.NewReader(payload))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/update_client_metrics_subscriptions", c.HostURL), bytes.NewReader(payload))
 	if err != nil {
 		return err
 	}
@@ -140,7 +141,7 @@ func (c *Client) UpdateClientMetricsSubscriptions(virtualClusterID string, subsc
 // The call is all-or-nothing: if any name is absent on the cluster, the
 // server returns ErrNotFound and no names are removed. Duplicate names in
 // the list are tolerated by the server. An empty batch is a no-op.
-func (c *Client) DeleteClientMetricsSubscriptions(virtualClusterID string, names []string) error {
+func (c *Client) DeleteClientMetricsSubscriptions(ctx context.Context, virtualClusterID string, names []string) error {
 	payload, err := json.Marshal(ClientMetricsSubscriptionsDeleteRequest{
 		VirtualClusterID:               virtualClusterID,
 		ClientMetricsSubscriptionNames: names,
@@ -149,7 +150,7 @@ func (c *Client) DeleteClientMetricsSubscriptions(virtualClusterID string, names
 		return err
 	}
 
-	req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("%s/delete_client_metrics_subscriptions", c.HostURL), bytes.NewReader(payload))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/delete_client_metrics_subscriptions", c.HostURL), bytes.NewReader(payload))
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,63 @@
+package api
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+func TestClientTrustsConfiguredCA(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"workspaces":[]}`))
+	}))
+	defer server.Close()
+
+	opts := DefaultClientOptions()
+	opts.MaxRetries = 0
+
+	// The test server's certificate is self-signed, so the system roots reject it.
+	client := newTestClientWithOptions(t, server.URL, opts)
+	if _, err := client.GetWorkspaces(t.Context()); err == nil {
+		t.Fatal("expected a certificate error without the test CA")
+	}
+
+	pool := x509.NewCertPool()
+	pool.AddCert(server.Certificate())
+	opts.TLSConfig = &tls.Config{RootCAs: pool, MinVersion: tls.VersionTLS12}
+	client = newTestClientWithOptions(t, server.URL, opts)
+	if _, err := client.GetWorkspaces(t.Context()); err != nil {
+		t.Fatalf("GetWorkspaces returned error: %v", err)
+	}
+}
+
+func TestClientUsesConfiguredProxy(t *testing.T) {
+	t.Parallel()
+
+	var proxiedHost string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxiedHost = r.URL.Host
+		_, _ = w.Write([]byte(`{"workspaces":[]}`))
+	}))
+	defer proxy.Close()
+
+	proxyURL, err := url.Parse(proxy.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	opts := DefaultClientOptions()
+	opts.MaxRetries = 0
+	opts.ProxyURL = proxyURL
+	client := newTestClientWithOptions(t, "http://api.warpstream.invalid/api/v1", opts)
+
+	if _, err := client.GetWorkspaces(t.Context()); err != nil {
+		t.Fatalf("GetWorkspaces returned error: %v", err)
+	}
+	if proxiedHost != "api.warpstream.invalid" {
+		t.Fatalf("expected the request to go through the proxy, proxy saw host %q", proxiedHost)
+	}
+}
@@ -0,0 +1,138 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+)
+
+// describeCacheTTL is how long a describe response is reused. It only needs to cover the burst of
+// identical describes a single plan or apply sends for the same virtual cluster.
+const describeCacheTTL = 5 * time.Second
+
+// During a plan, every resource attached to a virtual cluster describes it, and the virtual cluster
+// resource fans out to its configuration, tags and events state on top of that. Most of these calls
+// are identical and arrive at the same moment, so describeCache collapses concurrent identical
+// describes into one request and reuses the response for a few seconds.
+//
+// Entries are raw response bodies keyed by endpoint and virtual cluster, so every caller decodes its
+// own copy. Any mutation of a virtual cluster invalidates its entries, and describes that were in
+// flight during the mutation are not cached.
+type describeCache struct {
+	group singleflight.Group
+
+	mu sync.Mutex
+	// entries is keyed by endpoint path and virtual cluster ID.
+	entries map[describeCacheKey]describeCacheEntry
+	// generations counts the invalidations of each virtual cluster, and epoch those of the whole
+	// cache. A describe is only cached if neither moved while it was in flight.
+	generations map[string]uint64
+	epoch       uint64
+}
+
+type describeCacheKey struct {
+	path string
+	vcID string
+}
+
+type describeCacheEntry struct {
+	body    []byte
+	expires time.Time
+}
+
+// doDescribe sends a describe-style request about the virtual cluster vcID, going through the cache.
+func (c *Client) doDescribe(req *http.Request, vcID string) ([]byte, error) {
+	key := describeCacheKey{path: req.URL.Path, vcID: vcID}
+	if body, ok := c.describeCache.get(key); ok {
+		return body, nil
+	}
+
+	gen := c.describeCache.generation(vcID)
+	flightKey := key.path + "|" + key.vcID + "|" + strconv.FormatUint(gen, 10)
+
+	// The request runs detached from the first caller's cancellation, since other callers may be
+	// waiting on it. doRequest still bounds it with the call timeout.
+	detached := req.WithContext(context.WithoutCancel(req.Context()))
+	ch := c.describeCache.group.DoChan(flightKey, func() (any, error) {
+		body, err := c.doRequest(detached, nil)
+		if err != nil {
+			return nil, err
+		}
+		c.describeCache.set(key, gen, body)
+		return body, nil
+	})
+
+	select {
+	case res := <-ch:
+		if res.Err != nil {
+			return nil, res.Err
+		}
+		return res.Val.([]byte), nil
+	case <-req.Context().Done():
+		return nil, req.Context().Err()
+	}
+}
+
+func (c *describeCache) get(key describeCacheKey) ([]byte, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[key]
+	if !ok || time.Now().After(entry.expires) {
+		return nil, false
+	}
+	return entry.body, true
+}
+
+func (c *describeCache) generation(vcID string) uint64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.epoch + c.generations[vcID]
+}
+
+// set stores a response, unless the virtual cluster was invalidated since gen was read.
+func (c *describeCache) set(key describeCacheKey, gen uint64, body []byte) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.epoch+c.generations[key.vcID] != gen {
+		return
+	}
+
+	if c.entries == nil {
+		c.entries = make(map[describeCacheKey]describeCacheEntry)
+	}
+	c.entries[key] = describeCacheEntry{body: body, expires: time.Now().Add(describeCacheTTL)}
+}
+
+// invalidate drops every cached describe of the virtual cluster.
+func (c *describeCache) invalidate(vcID string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.generations == nil {
+		c.generations = make(map[string]uint64)
+	}
+	c.generations[vcID]++
+
+	for key := range c.entries {
+		if key.vcID == vcID {
+			delete(c.entries, key)
+		}
+	}
+}
+
+// invalidateAll drops every cached describe, for mutations that may show up in the describe of a
+// virtual cluster we can't name, such as deleting an agent key.
+func (c *describeCache) invalidateAll() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.epoch++
+	c.entries = nil
+}
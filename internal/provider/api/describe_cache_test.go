@@ -0,0 +1,184 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+)
+
+func TestClientDeduplicatesConcurrentDescribes(t *testing.T) {
+	t.Parallel()
+
+	state := newDescribeTestServerState()
+	release := make(chan struct{})
+	state.gate = release
+
+	server := newDescribeTestServer(state)
+	defer server.Close()
+
+	client := newACLTestClient(t, server.URL)
+
+	const callers = 20
+	var wg sync.WaitGroup
+	for range callers {
+		wg.Go(func() {
+			vc, err := client.GetVirtualCluster(t.Context(), "vc-1")
+			if err != nil {
+				t.Errorf("GetVirtualCluster returned error: %v", err)
+				return
+			}
+			if vc.Name != "vcn_test" {
+				t.Errorf("expected vcn_test, got %q", vc.Name)
+			}
+		})
+	}
+
+	// Wait for the first describe to reach the server before letting it answer, so that the other
+	// callers pile up behind it.
+	<-state.started
+	close(release)
+	wg.Wait()
+
+	if got := state.calls("/describe_virtual_cluster"); got != 1 {
+		t.Fatalf("expected concurrent describes to share 1 request, got %d", got)
+	}
+
+	if _, err := client.GetVirtualCluster(t.Context(), "vc-1"); err != nil {
+		t.Fatalf("GetVirtualCluster returned error: %v", err)
+	}
+
+	if got := state.calls("/describe_virtual_cluster"); got != 1 {
+		t.Fatalf("expected a describe right after to be memoized, got %d requests", got)
+	}
+}
+
+func TestClientDescribeCacheInvalidatedByMutation(t *testing.T) {
+	t.Parallel()
+
+	state := newDescribeTestServerState()
+	server := newDescribeTestServer(state)
+	defer server.Close()
+
+	client := newACLTestClient(t, server.URL)
+	vc := VirtualCluster{ID: "vc-1"}
+
+	tags, err := client.GetTags(t.Context(), vc)
+	if err != nil {
+		t.Fatalf("GetTags returned error: %v", err)
+	}
+	if tags["team"] != "a" {
+		t.Fatalf("expected team=a, got %v", tags)
+	}
+
+	if _, err := client.GetConfiguration(t.Context(), vc); err != nil {
+		t.Fatalf("GetConfiguration returned error: %v", err)
+	}
+
+	if err := client.UpdateTags(t.Context(), map[string]string{"team": "b"}, vc); err != nil {
+		t.Fatalf("UpdateTags returned error: %v", err)
+	}
+
+	tags, err = client.GetTags(t.Context(), vc)
+	if err != nil {
+		t.Fatalf("GetTags returned error: %v", err)
+	}
+	if tags["team"] != "b" {
+		t.Fatalf("expected the update to be visible, got %v", tags)
+	}
+
+	if _, err := client.GetConfiguration(t.Context(), vc); err != nil {
+		t.Fatalf("GetConfiguration returned error: %v", err)
+	}
+
+	if got := state.calls("/describe_virtual_cluster_tags"); got != 2 {
+		t.Fatalf("expected tags to be described again after the update, got %d requests", got)
+	}
+
+	if got := state.calls("/describe_virtual_cluster_configuration"); got != 2 {
+		t.Fatalf("expected the configuration to be described again after the update, got %d requests", got)
+	}
+
+	// Other virtual clusters keep their entries.
+	other := VirtualCluster{ID: "vc-2"}
+	if _, err := client.GetEventsState(t.Context(), other); err != nil {
+		t.Fatalf("GetEventsState returned error: %v", err)
+	}
+	if err := client.UpdateTags(t.Context(), map[string]string{"team": "c"}, vc); err != nil {
+		t.Fatalf("UpdateTags returned error: %v", err)
+	}
+	if _, err := client.GetEventsState(t.Context(), other); err != nil {
+		t.Fatalf("GetEventsState returned error: %v", err)
+	}
+
+	if got := state.calls("/get_events_state"); got != 1 {
+		t.Fatalf("expected vc-2 to stay cached across a vc-1 mutation, got %d requests", got)
+	}
+}
+
+type describeTestServerState struct {
+	mu        sync.Mutex
+	callsByEP map[string]int
+	tags      map[string]string
+	gate      chan struct{}
+	started   chan struct{}
+	startOnce sync.Once
+}
+
+func newDescribeTestServerState() *describeTestServerState {
+	return &describeTestServerState{
+		callsByEP: make(map[string]int),
+		tags:      map[string]string{"team": "a"},
+		started:   make(chan struct{}),
+	}
+}
+
+func (s *describeTestServerState) calls(path string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.callsByEP[path]
+}
+
+func newDescribeTestServer(state *describeTestServerState) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		state.mu.Lock()
+		state.callsByEP[r.URL.Path]++
+		gate := state.gate
+		state.mu.Unlock()
+
+		switch r.URL.Path {
+		case "/describe_virtual_cluster":
+			state.startOnce.Do(func() { close(state.started) })
+			if gate != nil {
+				<-gate
+			}
+			writeACLTestResponse(w, VirtualClusterDescribeResponse{VirtualCluster: VirtualCluster{ID: "vc-1", Name: "vcn_test"}})
+		case "/describe_virtual_cluster_tags":
+			state.mu.Lock()
+			tags := map[string]string{}
+			for k, v := range state.tags {
+				tags[k] = v
+			}
+			state.mu.Unlock()
+			writeACLTestResponse(w, TagsDescribeResponse{Tags: tags})
+		case "/describe_virtual_cluster_configuration":
+			writeACLTestResponse(w, ConfigurationDescribeResponse{})
+		case "/get_events_state":
+			writeACLTestResponse(w, EventsStateDescribeResponse{Enabled: true})
+		case "/update_virtual_cluster_tags":
+			var req TagsUpdateRequest
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			state.mu.Lock()
+			state.tags = req.Tags
+			state.mu.Unlock()
+			_, _ = w.Write([]byte("{}"))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+}
@@ -0,0 +1,120 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// requestIDHeaders lists the response headers that may carry the ID the control plane assigned to
+// a request, in order of preference.
+var requestIDHeaders = []string{
+	"Warpstream-Request-Id",
+	"X-Request-Id",
+	"X-Amzn-Requestid",
+}
+
+// APIError is returned for every non-200 response from the WarpStream API. Callers that need to
+// branch on the failure (e.g. a 403 vs. a 409) should use errors.As to retrieve it.
+//
+// APIError matches ErrNotFound under errors.Is when the status is 404, so existing not-found checks
+// keep working.
+type APIError struct {
+	// StatusCode is the HTTP status returned by the API.
+	StatusCode int
+	// Code is the machine-readable error code from the response body, e.g. "invalid_api_key".
+	// It is empty if the body did not carry one.
+	Code string
+	// Message is the human-readable error message from the response body. If the body could not be
+	// parsed, it holds the raw body instead.
+	Message string
+	// Path is the path of the endpoint that was called, e.g. "/api/v1/create_topic".
+	Path string
+	// RequestID is the request ID reported by the API, if any. Include it when contacting support.
+	RequestID string
+}
+
+// apiErrorBody is the shape of an error response body. Older endpoints only set "error".
+type apiErrorBody struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+	Error   string `json:"error"`
+}
+
+func newAPIError(res *http.Response, body []byte) *APIError {
+	apiErr := &APIError{
+		StatusCode: res.StatusCode,
+	}
+	if res.Request != nil && res.Request.URL != nil {
+		apiErr.Path = res.Request.URL.Path
+	}
+	for _, h := range requestIDHeaders {
+		if id := res.Header.Get(h); id != "" {
+			apiErr.RequestID = id
+			break
+		}
+	}
+
+	var parsed apiErrorBody
+	if err := json.Unmarshal(body, &parsed); err == nil {
+		apiErr.Code = parsed.Code
+		apiErr.Message = parsed.Message
+		if apiErr.Message == "" {
+			apiErr.Message = parsed.Error
+		}
+	}
+	if apiErr.Code == "" && apiErr.Message == "" {
+		apiErr.Message = strings.TrimSpace(string(body))
+	}
+
+	return apiErr
+}
+
+func (e *APIError) Error() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "status: %d", e.StatusCode)
+	if e.Path != "" {
+		fmt.Fprintf(&b, ", path: %s", e.Path)
+	}
+	if e.Code != "" {
+		fmt.Fprintf(&b, ", code: %s", e.Code)
+	}
+	if e.Message != "" {
+		fmt.Fprintf(&b, ", message: %s", e.Message)
+	}
+	if e.RequestID != "" {
+		fmt.Fprintf(&b, ", request id: %s", e.RequestID)
+	}
+	if e.StatusCode == http.StatusUnauthorized && e.isInvalidAPIKey() {
+		b.WriteString("\n\n Did you pass an authentication token to the provider?")
+	}
+	return b.String()
+}
+
+// Is reports whether the error is a 404, so that errors.Is(err, ErrNotFound) holds for it.
+func (e *APIError) Is(target error) bool {
+	return target == ErrNotFound && e.StatusCode == http.StatusNotFound
+}
+
+func (e *APIError) isInvalidAPIKey() bool {
+	return e.Code == "invalid_api_key" || strings.Contains(e.Message, "invalid_api_key")
+}
+
+// IsStatus reports whether err is, or wraps, an *APIError with the given HTTP status code.
+func IsStatus(err error, statusCode int) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == statusCode
+}
+
+// IsWorkspaceNotFound reports whether err is a 404 about a workspace, rather than about the
+// endpoint or another object of the request. The API reports every missing object with the same
+// "not_found" code, so the message tells them apart.
+func IsWorkspaceNotFound(err error) bool {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusNotFound {
+		return false
+	}
+	return strings.Contains(strings.ToLower(apiErr.Message), "workspace")
+}
@@ -0,0 +1,134 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestClientReturnsAPIError(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Request-Id", "req-123")
+		w.WriteHeader(http.StatusConflict)
+		_, _ = w.Write([]byte(`{"code":"duplicate_api_key_name","message":"an API key with this name already exists"}`))
+	}))
+	defer server.Close()
+
+	client := newACLTestClient(t, server.URL)
+
+	_, err := client.CreateApplicationKey(t.Context(), "akn_test", "", false)
+	if err == nil {
+		t.Fatal("expected CreateApplicationKey to return an error")
+	}
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("expected an *APIError, got %T: %v", err, err)
+	}
+
+	if apiErr.StatusCode != http.StatusConflict {
+		t.Fatalf("expected status %d, got %d", http.StatusConflict, apiErr.StatusCode)
+	}
+
+	if apiErr.Code != "duplicate_api_key_name" {
+		t.Fatalf("expected code duplicate_api_key_name, got %q", apiErr.Code)
+	}
+
+	if apiErr.Message != "an API key with this name already exists" {
+		t.Fatalf("unexpected message %q", apiErr.Message)
+	}
+
+	if apiErr.Path != "/create_api_key" {
+		t.Fatalf("expected path /create_api_key, got %q", apiErr.Path)
+	}
+
+	if apiErr.RequestID != "req-123" {
+		t.Fatalf("expected request id req-123, got %q", apiErr.RequestID)
+	}
+
+	if !IsStatus(err, http.StatusConflict) || IsStatus(err, http.StatusForbidden) {
+		t.Fatalf("IsStatus did not match the response status for %v", err)
+	}
+
+	if errors.Is(err, ErrNotFound) {
+		t.Fatal("expected a 409 not to match ErrNotFound")
+	}
+}
+
+func TestClientAPIErrorMatchesErrNotFound(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.NotFound(w, r)
+	}))
+	defer server.Close()
+
+	client := newACLTestClient(t, server.URL)
+
+	_, err := client.DescribeTopic(t.Context(), "vci_test", "orders")
+	if !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected a 404 to match ErrNotFound, got %v", err)
+	}
+
+	if !IsStatus(err, http.StatusNotFound) {
+		t.Fatalf("expected IsStatus to see the 404 through the wrapped error, got %v", err)
+	}
+}
+
+func TestClientAPIErrorUnparsableBody(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		_, _ = w.Write([]byte("invalid_api_key\n"))
+	}))
+	defer server.Close()
+
+	client := newACLTestClient(t, server.URL)
+
+	_, err := client.GetWorkspaces(t.Context())
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("expected an *APIError, got %T: %v", err, err)
+	}
+
+	if apiErr.Message != "invalid_api_key" {
+		t.Fatalf("expected the raw body as the message, got %q", apiErr.Message)
+	}
+
+	if !strings.Contains(err.Error(), "Did you pass an authentication token") {
+		t.Fatalf("expected the missing token hint in %q", err.Error())
+	}
+}
+
+func TestIsWorkspaceNotFound(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{name: "missing workspace", err: &APIError{StatusCode: http.StatusNotFound, Code: "not_found", Message: "workspace not found."}, want: true},
+		{name: "missing virtual cluster", err: &APIError{StatusCode: http.StatusNotFound, Code: "not_found", Message: "virtual cluster not found."}},
+		{name: "missing endpoint", err: &APIError{StatusCode: http.StatusNotFound, Message: "404 page not found"}},
+		{name: "other status", err: &APIError{StatusCode: http.StatusForbidden, Message: "workspace is not accessible"}},
+		{name: "not an API error", err: ErrNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := IsWorkspaceNotFound(fmt.Errorf("wrapped: %w", tt.err)); got != tt.want {
+				t.Fatalf("IsWorkspaceNotFound(%v) = %v, want %v", tt.err, got, tt.want)
+			}
+		})
+	}
+}
@@ -2,6 +2,7 @@ package api
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -39,18 +40,18 @@ type EventsStateUpdateRequest struct {
 }
 
 // GetEventsState - Describe virtual cluster events state.
-func (c *Client) GetEventsState(vc VirtualCluster) (*EventsState, error) {
+func (c *Client) GetEventsState(ctx context.Context, vc VirtualCluster) (*EventsState, error) {
 	payload, err := json.Marshal(EventsStateDescribeRequest{VirtualClusterID: vc.ID})
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("%s/get_events_state", c.HostURL), bytes.NewReader(payload))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/get_events_state", c.HostURL), bytes.NewReader(payload))
 	if err != nil {
 		return nil, err
 	}
 
-	body, err := c.doRequest(req, nil)
+	body, err := c.doDescribe(req, vc.ID)
 	if err != nil {
 		return nil, err
 	}
@@ -68,7 +69,7 @@ func (c *Client) GetEventsState(vc VirtualCluster) (*EventsState, error) {
 }
 
 // UpdateEventsState - Update virtual cluster events state.
-func (c *Client) UpdateEventsState(enabled *bool, eventTypes map[string]EventTypeConfig, vc VirtualCluster) error {
+func (c *Client) UpdateEventsState(ctx context.Context, enabled *bool, eventTypes map[string]EventTypeConfig, vc VirtualCluster) error {
 	payload, err := json.Marshal(EventsStateUpdateRequest{
 		VirtualClusterID: vc.ID,
 		Enabled:          enabled,
@@ -78,12 +79,13 @@ func (c *Client) UpdateEventsState(enabled *bool, eventTypes map[string]EventTyp
 		return err
 	}
 
-	req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("%s/update_events_state", c.HostURL), bytes.NewReader(payload))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/update_events_state", c.HostURL), bytes.NewReader(payload))
 	if err != nil {
 		return err
 	}
 
 	body, err := c.doRequest(req, nil)
+	c.describeCache.invalidate(vc.ID)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,134 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/go-retryablehttp"
+)
+
+// IdempotencyKeyHeader carries a key that stays the same across the retries of one logical create,
+// so that the control plane can recognize a create it has already processed.
+const IdempotencyKeyHeader = "Idempotency-Key"
+
+// orphanClockSkew is how far the control plane's clock may run behind ours when deciding whether an
+// object found after an ambiguous create was left behind by that create.
+const orphanClockSkew = time.Minute
+
+// errAmbiguousCreate matches create calls that failed without telling us whether the object was
+// created, e.g. because the response was lost after the control plane committed it.
+var errAmbiguousCreate = errors.New("create outcome unknown")
+
+type ambiguousCreateError struct {
+	err error
+}
+
+func (e *ambiguousCreateError) Error() string        { return e.err.Error() }
+func (e *ambiguousCreateError) Unwrap() error        { return e.err }
+func (e *ambiguousCreateError) Is(target error) bool { return target == errAmbiguousCreate }
+
+type idempotentCreateKey struct{}
+
+// idempotentCreate is attached to the context of a create call. doRequest sends its key on every
+// attempt, and records how many attempts were made.
+type idempotentCreate struct {
+	key      string
+	attempts int
+}
+
+func withIdempotencyKey(ctx context.Context) context.Context {
+	return context.WithValue(ctx, idempotentCreateKey{}, &idempotentCreate{key: uuid.NewString()})
+}
+
+func idempotentCreateFrom(ctx context.Context) *idempotentCreate {
+	create, _ := ctx.Value(idempotentCreateKey{}).(*idempotentCreate)
+	return create
+}
+
+// recordAttempt is a retryablehttp request hook counting the attempts made by a create call.
+func recordAttempt(_ retryablehttp.Logger, req *http.Request, attempt int) {
+	if create := idempotentCreateFrom(req.Context()); create != nil {
+		create.attempts = attempt + 1
+	}
+}
+
+// ambiguous reports whether a create that failed with err may still have created the object.
+// Transport errors, 5xx and 499 responses are ambiguous. So is a conflict on a retry, since it may
+// be the object created by an earlier attempt whose response was lost.
+func (create *idempotentCreate) ambiguous(err error) bool {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		return true
+	}
+	switch {
+	case apiErr.StatusCode >= http.StatusInternalServerError, apiErr.StatusCode == 499:
+		return true
+	case apiErr.StatusCode == http.StatusConflict:
+		return create.attempts > 1
+	default:
+		return false
+	}
+}
+
+// createIdempotently runs create with an idempotency key. If it fails ambiguously, lookup is used
+// to find the object by name: if it exists it is returned, otherwise create runs once more.
+func createIdempotently[T any](
+	ctx context.Context,
+	create func(ctx context.Context) (T, error),
+	lookup func(ctx context.Context) (T, bool, error),
+) (T, error) {
+	res, err := create(withIdempotencyKey(ctx))
+	if err == nil || !errors.Is(err, errAmbiguousCreate) {
+		return res, err
+	}
+
+	existing, found, lookupErr := lookup(ctx)
+	if lookupErr != nil {
+		return res, err
+	}
+	if found {
+		return existing, nil
+	}
+
+	return create(withIdempotencyKey(ctx))
+}
+
+// errNoSnapshot makes a lookup give up when the snapshot taken before the create failed, so that
+// createIdempotently returns the create's own error.
+var errNoSnapshot = errors.New("existing objects could not be listed before the create")
+
+// snapshotIDs returns the IDs that list reports before a create, so that recovery after an
+// ambiguous failure only touches objects that weren't there already. A failed list doesn't hold up
+// the create: the snapshot is nil, and recovery is skipped.
+func snapshotIDs(ctx context.Context, list func(ctx context.Context) ([]string, error)) map[string]bool {
+	ids, err := list(ctx)
+	if err != nil {
+		return nil
+	}
+	snapshot := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		snapshot[id] = true
+	}
+	return snapshot
+}
+
+// lookupResult adapts a Find-style (value, error) result to a createIdempotently lookup.
+func lookupResult[T any](v T, err error) (T, bool, error) {
+	if errors.Is(err, ErrNotFound) {
+		return v, false, nil
+	}
+	return v, err == nil, err
+}
+
+// createdSince reports whether createdAt, as returned by the API, is no earlier than since. Objects
+// whose creation time can't be parsed are assumed to be older.
+func createdSince(createdAt string, since time.Time) bool {
+	t, err := time.Parse(time.RFC3339Nano, createdAt)
+	if err != nil {
+		return false
+	}
+	return !t.Before(since.Add(-orphanClockSkew))
+}
@@ -0,0 +1,320 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+)
+
+func newIdempotencyTestClient(t *testing.T, host string, maxRetries int) *Client {
+	t.Helper()
+
+	opts := DefaultClientOptions()
+	opts.MaxRetries = maxRetries
+	opts.RetryWaitMin = time.Millisecond
+	opts.RetryWaitMax = time.Millisecond
+	return newTestClientWithOptions(t, host, opts)
+}
+
+func TestCreateSendsStableIdempotencyKey(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mu   sync.Mutex
+		keys []string
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		keys = append(keys, r.Header.Get(IdempotencyKeyHeader))
+		attempt := len(keys)
+		mu.Unlock()
+
+		if attempt == 1 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		_, _ = w.Write([]byte(`{"workspace_id":"wi_1"}`))
+	}))
+	defer server.Close()
+
+	client := newIdempotencyTestClient(t, server.URL, 2)
+
+	id, err := client.CreateWorkspace(t.Context(), "ws")
+	if err != nil {
+		t.Fatalf("CreateWorkspace returned error: %v", err)
+	}
+	if id != "wi_1" {
+		t.Fatalf("expected workspace wi_1, got %q", id)
+	}
+
+	if len(keys) != 2 {
+		t.Fatalf("expected 2 attempts, got %d", len(keys))
+	}
+	if keys[0] == "" || keys[0] != keys[1] {
+		t.Fatalf("expected the same idempotency key on every attempt, got %q", keys)
+	}
+
+	_, _ = client.GetWorkspaces(t.Context())
+	if last := keys[len(keys)-1]; last != "" {
+		t.Fatalf("expected no idempotency key outside of creates, got %q", last)
+	}
+}
+
+func TestCreateVirtualClusterRecoversAfterAmbiguousFailure(t *testing.T) {
+	t.Parallel()
+
+	var creates int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/create_virtual_cluster":
+			creates++
+			w.WriteHeader(http.StatusServiceUnavailable)
+		case "/list_virtual_clusters":
+			createdAt := time.Now().UTC().Format(time.RFC3339)
+			_, _ = w.Write([]byte(`{"virtual_clusters":[{"id":"vci_1","name":"vcn_test","created_at":"` + createdAt + `"}]}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	client := newIdempotencyTestClient(t, server.URL, 0)
+
+	vc, err := client.CreateVirtualCluster(t.Context(), "vcn_test", ClusterParameters{})
+	if err != nil {
+		t.Fatalf("CreateVirtualCluster returned error: %v", err)
+	}
+	if vc.ID != "vci_1" {
+		t.Fatalf("expected the existing virtual cluster vci_1, got %q", vc.ID)
+	}
+	if creates != 1 {
+		t.Fatalf("expected 1 create call, got %d", creates)
+	}
+}
+
+func TestCreateVirtualClusterDoesNotRecoverOlderCluster(t *testing.T) {
+	t.Parallel()
+
+	var creates int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/create_virtual_cluster":
+			creates++
+			if creates == 1 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			_, _ = w.Write([]byte(`{"virtual_cluster_id":"vci_2","virtual_cluster_name":"vcn_test"}`))
+		case "/list_virtual_clusters":
+			createdAt := time.Now().Add(-time.Hour).UTC().Format(time.RFC3339)
+			_, _ = w.Write([]byte(`{"virtual_clusters":[{"id":"vci_1","name":"vcn_test","created_at":"` + createdAt + `"}]}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	client := newIdempotencyTestClient(t, server.URL, 0)
+
+	vc, err := client.CreateVirtualCluster(t.Context(), "vcn_test", ClusterParameters{})
+	if err != nil {
+		t.Fatalf("CreateVirtualCluster returned error: %v", err)
+	}
+	if vc.ID != "vci_2" {
+		t.Fatalf("expected the newly created virtual cluster vci_2, got %q", vc.ID)
+	}
+	if creates != 2 {
+		t.Fatalf("expected 2 create calls, got %d", creates)
+	}
+}
+
+func TestCreateWorkspaceDoesNotRecoverOlderWorkspace(t *testing.T) {
+	t.Parallel()
+
+	var creates int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/create_workspace":
+			creates++
+			if creates == 1 {
+				w.WriteHeader(http.StatusBadGateway)
+				return
+			}
+			_, _ = w.Write([]byte(`{"workspace_id":"wi_2"}`))
+		case "/list_workspaces":
+			createdAt := time.Now().Add(-time.Hour).UTC().Format(time.RFC3339)
+			_, _ = w.Write([]byte(`{"workspaces":[{"id":"wi_1","name":"ws","created_at":"` + createdAt + `"}]}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	client := newIdempotencyTestClient(t, server.URL, 0)
+
+	id, err := client.CreateWorkspace(t.Context(), "ws")
+	if err != nil {
+		t.Fatalf("CreateWorkspace returned error: %v", err)
+	}
+	if id != "wi_2" {
+		t.Fatalf("expected the newly created workspace wi_2, got %q", id)
+	}
+	if creates != 2 {
+		t.Fatalf("expected 2 create calls, got %d", creates)
+	}
+}
+
+func TestCreatePipelineDoesNotRecoverOlderPipeline(t *testing.T) {
+	t.Parallel()
+
+	var creates int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/create_pipeline":
+			creates++
+			if creates == 1 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			_, _ = w.Write([]byte(`{"pipeline_id":"pi_2","pipeline_name":"pipeline"}`))
+		case "/list_pipelines":
+			_, _ = w.Write([]byte(`{"pipelines":[{"id":"pi_1","name":"pipeline"}]}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	client := newIdempotencyTestClient(t, server.URL, 0)
+
+	pipeline, err := client.CreatePipeline(t.Context(), HTTPCreatePipelineRequest{VirtualClusterID: "vci_1", PipelineName: "pipeline"})
+	if err != nil {
+		t.Fatalf("CreatePipeline returned error: %v", err)
+	}
+	if pipeline.PipelineID != "pi_2" {
+		t.Fatalf("expected the newly created pipeline pi_2, got %q", pipeline.PipelineID)
+	}
+	if creates != 2 {
+		t.Fatalf("expected 2 create calls, got %d", creates)
+	}
+}
+
+func TestCreateAPIKeyReplacesOrphanAfterConflictOnRetry(t *testing.T) {
+	t.Parallel()
+
+	var (
+		creates int
+		deleted []string
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/create_api_key":
+			creates++
+			switch creates {
+			case 1:
+				w.WriteHeader(http.StatusInternalServerError)
+			case 2:
+				w.WriteHeader(http.StatusConflict)
+				_, _ = w.Write([]byte(`{"code":"duplicate_api_key_name"}`))
+			default:
+				_, _ = w.Write([]byte(`{"id":"ak_2","name":"akn_test","key":"aks_new"}`))
+			}
+		case "/list_api_keys":
+			// ak_old had the name before the create; ak_1 was left behind by the first attempt.
+			keys := `{"id":"ak_old","name":"akn_test"}`
+			if creates > 0 {
+				keys += `,{"id":"ak_1","name":"akn_test"}`
+			}
+			_, _ = w.Write([]byte(`{"api_keys":[` + keys + `]}`))
+		case "/delete_api_key":
+			var req struct {
+				ID string `json:"api_key_id"`
+			}
+			_ = json.NewDecoder(r.Body).Decode(&req)
+			deleted = append(deleted, req.ID)
+			_, _ = w.Write([]byte(`{}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	client := newIdempotencyTestClient(t, server.URL, 1)
+
+	key, err := client.CreateApplicationKey(t.Context(), "akn_test", "", false)
+	if err != nil {
+		t.Fatalf("CreateApplicationKey returned error: %v", err)
+	}
+	if key.ID != "ak_2" || key.Key != "aks_new" {
+		t.Fatalf("expected the recreated key ak_2, got %+v", key)
+	}
+	if !slices.Equal(deleted, []string{"ak_1"}) {
+		t.Fatalf("expected only the orphaned key ak_1 to be deleted, got %v", deleted)
+	}
+}
+
+func TestCreateDoesNotRecoverFromConflictOnFirstAttempt(t *testing.T) {
+	t.Parallel()
+
+	var lists int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/create_workspace":
+			w.WriteHeader(http.StatusConflict)
+			_, _ = w.Write([]byte(`{"code":"duplicate_workspace_name"}`))
+		case "/list_workspaces":
+			lists++
+			_, _ = w.Write([]byte(`{"workspaces":[{"id":"wi_1","name":"ws"}]}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	client := newIdempotencyTestClient(t, server.URL, 2)
+
+	if _, err := client.CreateWorkspace(t.Context(), "ws"); !IsStatus(err, http.StatusConflict) {
+		t.Fatalf("expected the conflict to be returned, got %v", err)
+	}
+	if lists != 0 {
+		t.Fatalf("expected no lookup after an unambiguous failure, got %d", lists)
+	}
+}
+
+func TestCreatePipelineWithoutSnapshotSkipsRecovery(t *testing.T) {
+	t.Parallel()
+
+	var creates, lists int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/create_pipeline":
+			creates++
+			if creates == 1 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			_, _ = w.Write([]byte(`{"pipeline_id":"pi_1","pipeline_name":"pipeline"}`))
+		case "/list_pipelines":
+			lists++
+			w.WriteHeader(http.StatusBadRequest)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	client := newIdempotencyTestClient(t, server.URL, 0)
+
+	// The failed snapshot doesn't hold up the create.
+	pipeline, err := client.CreatePipeline(t.Context(), HTTPCreatePipelineRequest{VirtualClusterID: "vci_1", PipelineName: "pipeline"})
+	if !IsStatus(err, http.StatusServiceUnavailable) {
+		t.Fatalf("expected the create's own error without recovery, got %+v, %v", pipeline, err)
+	}
+	if creates != 1 || lists != 1 {
+		t.Fatalf("expected 1 create and only the snapshot list, got %d creates and %d lists", creates, lists)
+	}
+}
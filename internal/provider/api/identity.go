@@ -0,0 +1,92 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// KeyKind is the kind of API key the client authenticates with.
+type KeyKind string
+
+const (
+	// KeyKindUnknown is used when the key has not been identified.
+	KeyKindUnknown     KeyKind = ""
+	KeyKindAccount     KeyKind = "account"
+	KeyKindApplication KeyKind = "application"
+	KeyKindAgent       KeyKind = "agent"
+)
+
+// KeyIdentity describes the API key the client authenticates with.
+type KeyIdentity struct {
+	Kind KeyKind
+	// WorkspaceID is the workspace of an application key. It is empty if the workspace has no
+	// virtual clusters to tell it by.
+	WorkspaceID string
+	// WorkspaceIDs lists every workspace in the account of an account key.
+	WorkspaceIDs []string
+}
+
+// String describes the key for error messages, e.g. "an application key for workspace wi_...".
+func (k KeyIdentity) String() string {
+	switch k.Kind {
+	case KeyKindAccount:
+		return "an account key"
+	case KeyKindApplication:
+		if k.WorkspaceID != "" {
+			return "an application key for workspace " + k.WorkspaceID
+		}
+		return "an application key"
+	case KeyKindAgent:
+		return "an agent key"
+	}
+	return "an API key of unknown kind"
+}
+
+// Identify works out the kind of the client's API key. The API has no endpoint describing the
+// calling key, so it lists workspaces, which only account keys may do. Other keys are told apart
+// by listing virtual clusters, which application keys may do and agent keys may not. The API may
+// refuse a key on an endpoint it can't call with either 401 or 403.
+//
+// An invalid key fails with the API's 401 error, which listing virtual clusters returns too.
+func (c *Client) Identify(ctx context.Context) (KeyIdentity, error) {
+	workspaces, err := c.GetWorkspaces(ctx)
+	if err == nil {
+		identity := KeyIdentity{Kind: KeyKindAccount}
+		for _, ws := range workspaces {
+			identity.WorkspaceIDs = append(identity.WorkspaceIDs, ws.ID)
+		}
+		return identity, nil
+	}
+	if !IsStatus(err, http.StatusUnauthorized) && !IsStatus(err, http.StatusForbidden) {
+		return KeyIdentity{}, err
+	}
+
+	clusters, err := c.GetVirtualClusters(ctx)
+	if IsStatus(err, http.StatusForbidden) {
+		return KeyIdentity{Kind: KeyKindAgent}, nil
+	}
+	if err != nil {
+		return KeyIdentity{}, err
+	}
+	identity := KeyIdentity{Kind: KeyKindApplication}
+	if len(clusters) > 0 {
+		identity.WorkspaceID = clusters[0].WorkspaceID
+	}
+	return identity, nil
+}
+
+// RequireKeyKind returns an error naming resourceType when the client's key has been identified
+// and is none of kinds.
+func (c *Client) RequireKeyKind(resourceType string, kinds ...KeyKind) error {
+	if c.Identity.Kind == KeyKindUnknown {
+		return nil
+	}
+	for _, kind := range kinds {
+		if c.Identity.Kind == kind {
+			return nil
+		}
+	}
+	return fmt.Errorf("%s requires %s, but the provider is configured with %s",
+		resourceType, KeyIdentity{Kind: kinds[0]}, c.Identity)
+}
@@ -0,0 +1,116 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestIdentify(t *testing.T) {
+	t.Parallel()
+
+	forbidden := `{"code":"forbidden","message":"this key may not call this endpoint"}`
+	tests := []struct {
+		name       string
+		workspaces int // status of list_workspaces
+		clusters   int // status of list_virtual_clusters
+		want       KeyIdentity
+		wantErr    bool
+	}{
+		{
+			name:       "account key",
+			workspaces: http.StatusOK,
+			want:       KeyIdentity{Kind: KeyKindAccount, WorkspaceIDs: []string{"wi_a", "wi_b"}},
+		},
+		{
+			name:       "application key",
+			workspaces: http.StatusForbidden,
+			clusters:   http.StatusOK,
+			want:       KeyIdentity{Kind: KeyKindApplication, WorkspaceID: "wi_app"},
+		},
+		{
+			name:       "application key refused with 401",
+			workspaces: http.StatusUnauthorized,
+			clusters:   http.StatusOK,
+			want:       KeyIdentity{Kind: KeyKindApplication, WorkspaceID: "wi_app"},
+		},
+		{
+			name:       "agent key",
+			workspaces: http.StatusForbidden,
+			clusters:   http.StatusForbidden,
+			want:       KeyIdentity{Kind: KeyKindAgent},
+		},
+		{
+			name:       "invalid key",
+			workspaces: http.StatusUnauthorized,
+			clusters:   http.StatusUnauthorized,
+			wantErr:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch {
+				case strings.HasSuffix(r.URL.Path, "/list_workspaces"):
+					w.WriteHeader(tt.workspaces)
+					if tt.workspaces != http.StatusOK {
+						_, _ = w.Write([]byte(forbidden))
+						return
+					}
+					_, _ = w.Write([]byte(`{"workspaces":[{"id":"wi_a"},{"id":"wi_b"}]}`))
+				case strings.HasSuffix(r.URL.Path, "/list_virtual_clusters"):
+					w.WriteHeader(tt.clusters)
+					if tt.clusters != http.StatusOK {
+						_, _ = w.Write([]byte(forbidden))
+						return
+					}
+					_, _ = w.Write([]byte(`{"virtual_clusters":[{"id":"vci_a","workspace_id":"wi_app"}]}`))
+				default:
+					t.Errorf("unexpected request to %s", r.URL.Path)
+				}
+			}))
+			defer server.Close()
+
+			opts := DefaultClientOptions()
+			opts.MaxRetries = 0
+			client := newTestClientWithOptions(t, server.URL, opts)
+
+			got, err := client.Identify(t.Context())
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected an error, got %+v", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Identify returned error: %v", err)
+			}
+			if got.Kind != tt.want.Kind || got.WorkspaceID != tt.want.WorkspaceID || !slices.Equal(got.WorkspaceIDs, tt.want.WorkspaceIDs) {
+				t.Fatalf("expected %+v, got %+v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestRequireKeyKind(t *testing.T) {
+	t.Parallel()
+
+	client := &Client{Identity: KeyIdentity{Kind: KeyKindApplication, WorkspaceID: "wi_app"}}
+	err := client.RequireKeyKind("warpstream_workspace", KeyKindAccount)
+	want := "warpstream_workspace requires an account key, but the provider is configured with an application key for workspace wi_app"
+	if err == nil || err.Error() != want {
+		t.Fatalf("expected %q, got %v", want, err)
+	}
+
+	if err := client.RequireKeyKind("warpstream_topic", KeyKindApplication, KeyKindAccount); err != nil {
+		t.Fatalf("RequireKeyKind returned error: %v", err)
+	}
+	if err := (&Client{}).RequireKeyKind("warpstream_workspace", KeyKindAccount); err != nil {
+		t.Fatalf("expected an unidentified key to pass, got %v", err)
+	}
+}
@@ -0,0 +1,129 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// logSubsystem is the tflog subsystem the API client logs under. Its level can be set on its own
+// with TF_LOG_PROVIDER_WARPSTREAM_API, e.g. to trace API traffic without the rest of the provider.
+const logSubsystem = "warpstream_api"
+
+const redactedValue = "***REDACTED***"
+
+// redactedHeaders are request and response headers that are never logged verbatim.
+var redactedHeaders = map[string]struct{}{
+	http.CanonicalHeaderKey("warpstream-api-key"): {},
+	http.CanonicalHeaderKey("Authorization"):      {},
+	http.CanonicalHeaderKey("Cookie"):             {},
+	http.CanonicalHeaderKey("Set-Cookie"):         {},
+}
+
+// redactedFields are JSON object keys whose values are never logged verbatim, wherever they appear
+// in a request or response body.
+var redactedFields = map[string]struct{}{
+	"key":                 {},
+	"password":            {},
+	"imported_password":   {},
+	"signing_certificate": {},
+	"token":               {},
+}
+
+// secretValuePattern matches API keys and credentials by prefix, so that they are masked even when
+// they show up under a key we don't know about, or inside a larger string such as a pipeline YAML.
+var secretValuePattern = regexp.MustCompile(`\b(aks|ccp|sks)_[A-Za-z0-9_\-]+`)
+
+func newLogContext(ctx context.Context) context.Context {
+	return tflog.NewSubsystem(ctx, logSubsystem,
+		tflog.WithLevelFromEnv("TF_LOG_PROVIDER_WARPSTREAM_API"),
+		tflog.WithRootFields(),
+	)
+}
+
+// RedactHeaders returns the headers as a flat map with secret values masked. Besides debug logs,
+// it is used by test tooling that writes API traffic to disk.
+func RedactHeaders(h http.Header) map[string]string {
+	out := make(map[string]string, len(h))
+	for k, v := range h {
+		if _, ok := redactedHeaders[http.CanonicalHeaderKey(k)]; ok {
+			out[k] = redactedValue
+			continue
+		}
+		out[k] = redactString(strings.Join(v, ", "))
+	}
+	return out
+}
+
+// lazyRedactedBody defers RedactBody until a log line carrying the body is written. tflog only
+// formats fields once the subsystem's level lets the line through, so runs that don't log API
+// traffic at debug level never parse the bodies.
+type lazyRedactedBody struct {
+	body []byte
+}
+
+func (b lazyRedactedBody) String() string {
+	return RedactBody(b.body)
+}
+
+func (b lazyRedactedBody) MarshalJSON() ([]byte, error) {
+	return json.Marshal(RedactBody(b.body))
+}
+
+// RedactBody returns a request or response body with secret values masked. JSON bodies have the
+// values of redactedFields replaced; anything else only has prefixed keys masked. Like
+// RedactHeaders, it is shared with test tooling that writes API traffic to disk.
+func RedactBody(body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(body))
+	dec.UseNumber()
+
+	var parsed any
+	if err := dec.Decode(&parsed); err != nil {
+		return redactString(string(body))
+	}
+
+	redacted, err := json.Marshal(redactJSONValue(parsed))
+	if err != nil {
+		return redactString(string(body))
+	}
+	return string(redacted)
+}
+
+func redactJSONValue(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		for k, child := range v {
+			if _, ok := redactedFields[strings.ToLower(k)]; ok {
+				if s, isString := child.(string); isString && s == "" {
+					continue
+				}
+				v[k] = redactedValue
+				continue
+			}
+			v[k] = redactJSONValue(child)
+		}
+		return v
+	case []any:
+		for i, child := range v {
+			v[i] = redactJSONValue(child)
+		}
+		return v
+	case string:
+		return redactString(v)
+	default:
+		return v
+	}
+}
+
+func redactString(s string) string {
+	return secretValuePattern.ReplaceAllString(s, "${1}_"+redactedValue)
+}
@@ -0,0 +1,103 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestRedactBody(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       string
+		wantAbsent []string
+		wantKept   []string
+	}{
+		{
+			name:       "api key response",
+			body:       `{"id":"ak_123","name":"akn_test","key":"aks_0123456789abcdef","access_grants":[]}`,
+			wantAbsent: []string{"aks_0123456789abcdef"},
+			wantKept:   []string{"ak_123", "akn_test"},
+		},
+		{
+			name:       "credentials",
+			body:       `{"credentials_name":"ccn_test","imported_password":"hunter2","virtual_cluster_id":"vci_1"}`,
+			wantAbsent: []string{"hunter2"},
+			wantKept:   []string{"ccn_test", "vci_1"},
+		},
+		{
+			name:       "nested password",
+			body:       `{"credentials":[{"id":"cred_1","username":"ccun_x","password":"ccp_secretvalue"}]}`,
+			wantAbsent: []string{"ccp_secretvalue"},
+			wantKept:   []string{"cred_1", "ccun_x"},
+		},
+		{
+			name:       "sso signing certificate",
+			body:       `{"sso_configuration":{"id":"sso_1","signing_certificate":"-----BEGIN CERTIFICATE-----MIIB"}}`,
+			wantAbsent: []string{"MIIB"},
+			wantKept:   []string{"sso_1"},
+		},
+		{
+			name:       "prefixed value under an unknown key",
+			body:       `{"configuration_yaml":"sasl_password: sks_abcdef123\nother: value"}`,
+			wantAbsent: []string{"sks_abcdef123"},
+			wantKept:   []string{"other: value"},
+		},
+		{
+			name:       "non json body",
+			body:       `invalid key aks_abcdef`,
+			wantAbsent: []string{"aks_abcdef"},
+			wantKept:   []string{"invalid key"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := RedactBody([]byte(tt.body))
+			for _, s := range tt.wantAbsent {
+				if strings.Contains(got, s) {
+					t.Errorf("RedactBody() = %q, expected %q to be masked", got, s)
+				}
+			}
+			for _, s := range tt.wantKept {
+				if !strings.Contains(got, s) {
+					t.Errorf("RedactBody() = %q, expected %q to be kept", got, s)
+				}
+			}
+		})
+	}
+}
+
+func TestLazyRedactedBody(t *testing.T) {
+	body := lazyRedactedBody{[]byte(`{"id":"ak_123","key":"aks_0123456789abcdef"}`)}
+
+	if got := fmt.Sprintf("%v", body); strings.Contains(got, "aks_0123456789abcdef") || !strings.Contains(got, "ak_123") {
+		t.Errorf("formatted body = %q, expected the key to be masked", got)
+	}
+
+	encoded, err := json.Marshal(map[string]any{"response_body": body})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(encoded), "aks_0123456789abcdef") || !strings.Contains(string(encoded), "ak_123") {
+		t.Errorf("encoded body = %s, expected the key to be masked", encoded)
+	}
+}
+
+func TestRedactHeaders(t *testing.T) {
+	h := http.Header{}
+	h.Set("warpstream-api-key", "aks_0123456789abcdef")
+	h.Set("Content-Type", "application/json")
+
+	got := RedactHeaders(h)
+
+	if got["Warpstream-Api-Key"] != redactedValue {
+		t.Errorf("expected API key header to be masked, got %q", got["Warpstream-Api-Key"])
+	}
+
+	if got["Content-Type"] != "application/json" {
+		t.Errorf("expected Content-Type to be kept, got %q", got["Content-Type"])
+	}
+}
@@ -6,7 +6,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
-	"time"
 )
 
 type HTTPListPipelinesRequest struct {
@@ -93,6 +92,25 @@ type HTTPPipelineConfiguration struct {
 func (c *Client) CreatePipeline(
 	ctx context.Context,
 	req HTTPCreatePipelineRequest,
+) (HTTPCreatePipelineResponse, error) {
+	// Pipelines don't report when they were created, so note the ones that already have the name to
+	// avoid recovering one of those after an ambiguous failure.
+	existing := snapshotIDs(ctx, func(ctx context.Context) ([]string, error) {
+		return c.pipelineIDsNamed(ctx, req)
+	})
+	return createIdempotently(ctx,
+		func(ctx context.Context) (HTTPCreatePipelineResponse, error) {
+			return c.createPipeline(ctx, req)
+		},
+		func(ctx context.Context) (HTTPCreatePipelineResponse, bool, error) {
+			return c.findCreatedPipeline(ctx, req, existing)
+		},
+	)
+}
+
+func (c *Client) createPipeline(
+	ctx context.Context,
+	req HTTPCreatePipelineRequest,
 ) (HTTPCreatePipelineResponse, error) {
 	req.Type = remapPipelineTypeRequest(req.Type)
 	resp := &HTTPCreatePipelineResponse{}
@@ -103,6 +121,54 @@ func (c *Client) CreatePipeline(
 	return *resp, nil
 }
 
+// pipelineIDsNamed returns the IDs of the pipelines that already have the name req creates.
+func (c *Client) pipelineIDsNamed(
+	ctx context.Context,
+	req HTTPCreatePipelineRequest,
+) ([]string, error) {
+	list, err := c.ListPipelines(ctx, HTTPListPipelinesRequest{VirtualClusterID: req.VirtualClusterID})
+	if err != nil {
+		return nil, err
+	}
+	var ids []string
+	for _, pipeline := range list.Pipelines {
+		if pipeline.Name == req.PipelineName {
+			ids = append(ids, pipeline.ID)
+		}
+	}
+	return ids, nil
+}
+
+// findCreatedPipeline looks up a pipeline by the name it was created with, to recover from an
+// ambiguous CreatePipeline failure. Pipelines in existing predate the create and are skipped; with
+// no snapshot at all, the pipeline can't be told apart and recovery is skipped.
+func (c *Client) findCreatedPipeline(
+	ctx context.Context,
+	req HTTPCreatePipelineRequest,
+	existing map[string]bool,
+) (HTTPCreatePipelineResponse, bool, error) {
+	if existing == nil {
+		return HTTPCreatePipelineResponse{}, false, errNoSnapshot
+	}
+
+	list, err := c.ListPipelines(ctx, HTTPListPipelinesRequest{VirtualClusterID: req.VirtualClusterID})
+	if err != nil {
+		return HTTPCreatePipelineResponse{}, false, err
+	}
+	for _, pipeline := range list.Pipelines {
+		if pipeline.Name == req.PipelineName && !existing[pipeline.ID] {
+			return HTTPCreatePipelineResponse{
+				PipelineID:                      pipeline.ID,
+				PipelineName:                    pipeline.Name,
+				PipelineState:                   pipeline.State,
+				PipelineType:                    remapPipelineTypeResponse(pipeline.Type),
+				PipelineDeployedConfigurationId: pipeline.DeployedConfigurationId,
+			}, true, nil
+		}
+	}
+	return HTTPCreatePipelineResponse{}, false, nil
+}
+
 func (c *Client) ListPipelines(
 	ctx context.Context,
 	req HTTPListPipelinesRequest,
@@ -168,9 +234,6 @@ func (c *Client) doJSONHTTP(
 	path string,
 	resp any,
 ) error {
-	ctx, cc := context.WithTimeout(ctx, 4*time.Minute)
-	defer cc()
-
 	marshaled, err := json.Marshal(&req)
 	if err != nil {
 		return fmt.Errorf("error marshaling request: %w", err)
@@ -0,0 +1,153 @@
+package api
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// limitedTransport throttles every HTTP attempt the client makes, retries included. It enforces
+// the client's requests-per-second and max-in-flight limits, and when the API answers 429 or 503
+// with a Retry-After header it holds back all further requests until that time has passed, so that
+// concurrent resource operations slow down together instead of each burning through its retries.
+//
+// The per-attempt timeout is applied here, around the request itself, rather than by the
+// http.Client, so that time spent waiting on the limits doesn't count against it.
+type limitedTransport struct {
+	base http.RoundTripper
+	// timeout caps the request once it is let through; zero means no cap.
+	timeout time.Duration
+	// rate is nil when requests per second are unlimited.
+	rate *rate.Limiter
+	// inFlight is nil when the number of concurrent requests is unlimited.
+	inFlight chan struct{}
+
+	mu          sync.Mutex
+	pausedUntil time.Time
+}
+
+func newLimitedTransport(base http.RoundTripper, opts ClientOptions) *limitedTransport {
+	t := &limitedTransport{base: base, timeout: opts.RequestTimeout}
+	if opts.RequestsPerSecond > 0 {
+		burst := opts.RateLimitBurst
+		if burst <= 0 {
+			burst = max(1, int(opts.RequestsPerSecond))
+		}
+		t.rate = rate.NewLimiter(rate.Limit(opts.RequestsPerSecond), burst)
+	}
+	if opts.MaxInFlight > 0 {
+		t.inFlight = make(chan struct{}, opts.MaxInFlight)
+	}
+	return t
+}
+
+func (t *limitedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+
+	if t.inFlight != nil {
+		select {
+		case t.inFlight <- struct{}{}:
+			defer func() { <-t.inFlight }()
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	if err := t.waitForPause(ctx); err != nil {
+		return nil, err
+	}
+
+	if t.rate != nil {
+		if err := t.rate.Wait(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	res, err := t.roundTripWithTimeout(req)
+	if err == nil && (res.StatusCode == http.StatusTooManyRequests || res.StatusCode == http.StatusServiceUnavailable) {
+		if wait, ok := parseRetryAfter(res.Header.Get("Retry-After")); ok {
+			t.pause(wait)
+		}
+	}
+	return res, err
+}
+
+// roundTripWithTimeout sends the request, giving up once the timeout has passed. The timeout covers
+// reading the response body too, so it is only released when the body is closed.
+func (t *limitedTransport) roundTripWithTimeout(req *http.Request) (*http.Response, error) {
+	if t.timeout <= 0 {
+		return t.base.RoundTrip(req)
+	}
+
+	ctx, cancel := context.WithTimeout(req.Context(), t.timeout)
+	res, err := t.base.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	res.Body = &cancelOnClose{ReadCloser: res.Body, cancel: cancel}
+	return res, nil
+}
+
+// cancelOnClose releases a request's timeout when its response body is closed.
+type cancelOnClose struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelOnClose) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
+
+func (t *limitedTransport) waitForPause(ctx context.Context) error {
+	for {
+		t.mu.Lock()
+		wait := time.Until(t.pausedUntil)
+		t.mu.Unlock()
+		if wait <= 0 {
+			return nil
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		}
+	}
+}
+
+func (t *limitedTransport) pause(d time.Duration) {
+	until := time.Now().Add(d)
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if until.After(t.pausedUntil) {
+		t.pausedUntil = until
+	}
+}
+
+// parseRetryAfter parses a Retry-After header given either in seconds or as an HTTP date.
+func parseRetryAfter(v string) (time.Duration, bool) {
+	if v == "" {
+		return 0, false
+	}
+	if secs, err := strconv.ParseInt(v, 10, 64); err == nil {
+		if secs < 0 {
+			return 0, false
+		}
+		return time.Duration(secs) * time.Second, true
+	}
+	if at, err := http.ParseTime(v); err == nil {
+		return max(0, time.Until(at)), true
+	}
+	return 0, false
+}
@@ -0,0 +1,168 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestClientHonorsRetryAfter(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) == 1 {
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		_, _ = w.Write([]byte(`{"workspaces":[]}`))
+	}))
+	defer server.Close()
+
+	opts := DefaultClientOptions()
+	opts.RetryWaitMin = time.Millisecond
+	opts.RetryWaitMax = time.Millisecond
+	client := newTestClientWithOptions(t, server.URL, opts)
+
+	start := time.Now()
+	if _, err := client.GetWorkspaces(t.Context()); err != nil {
+		t.Fatalf("GetWorkspaces returned error: %v", err)
+	}
+
+	if elapsed := time.Since(start); elapsed < time.Second {
+		t.Fatalf("expected the client to wait out Retry-After, retried after %s", elapsed)
+	}
+	if got := calls.Load(); got != 2 {
+		t.Fatalf("expected 2 requests, got %d", got)
+	}
+}
+
+func TestClientRetryAfterPauseIsNotARequestTimeout(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) == 1 {
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		_, _ = w.Write([]byte(`{"workspaces":[]}`))
+	}))
+	defer server.Close()
+
+	opts := DefaultClientOptions()
+	opts.MaxRetries = 0
+	opts.RequestTimeout = 200 * time.Millisecond
+	client := newTestClientWithOptions(t, server.URL, opts)
+
+	// The first call gets the 429, which pauses every request for longer than RequestTimeout.
+	if _, err := client.GetWorkspaces(t.Context()); err == nil {
+		t.Fatal("expected the throttled call to fail without retries")
+	}
+
+	start := time.Now()
+	if _, err := client.GetWorkspaces(t.Context()); err != nil {
+		t.Fatalf("expected the call to wait out the pause, got: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed < 500*time.Millisecond {
+		t.Fatalf("expected the call to wait out Retry-After, sent after %s", elapsed)
+	}
+}
+
+func TestClientRequestTimeoutCapsSlowRequests(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(2 * time.Second):
+		}
+	}))
+	defer server.Close()
+
+	opts := DefaultClientOptions()
+	opts.MaxRetries = 0
+	opts.RequestTimeout = 100 * time.Millisecond
+	client := newTestClientWithOptions(t, server.URL, opts)
+
+	start := time.Now()
+	if _, err := client.GetWorkspaces(t.Context()); err == nil {
+		t.Fatal("expected the slow request to time out")
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Fatalf("expected the request to time out after RequestTimeout, took %s", elapsed)
+	}
+}
+
+func TestClientLimitsRequestsInFlight(t *testing.T) {
+	t.Parallel()
+
+	var inFlight, peak atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			p := peak.Load()
+			if n <= p || peak.CompareAndSwap(p, n) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+		_, _ = w.Write([]byte(`{"workspaces":[]}`))
+	}))
+	defer server.Close()
+
+	opts := DefaultClientOptions()
+	opts.MaxInFlight = 2
+	client := newTestClientWithOptions(t, server.URL, opts)
+
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Go(func() {
+			if _, err := client.GetWorkspaces(t.Context()); err != nil {
+				t.Errorf("GetWorkspaces returned error: %v", err)
+			}
+		})
+	}
+	wg.Wait()
+
+	if got := peak.Load(); got > 2 {
+		t.Fatalf("expected at most 2 requests in flight, saw %d", got)
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	t.Parallel()
+
+	if d, ok := parseRetryAfter("3"); !ok || d != 3*time.Second {
+		t.Fatalf("parseRetryAfter(\"3\") = %s, %v", d, ok)
+	}
+
+	at := time.Now().Add(time.Minute).UTC().Format(http.TimeFormat)
+	if d, ok := parseRetryAfter(at); !ok || d <= 0 || d > time.Minute {
+		t.Fatalf("parseRetryAfter(%q) = %s, %v", at, d, ok)
+	}
+
+	for _, v := range []string{"", "-1", "soon"} {
+		if _, ok := parseRetryAfter(v); ok {
+			t.Fatalf("expected parseRetryAfter(%q) to fail", v)
+		}
+	}
+}
+
+func newTestClientWithOptions(t *testing.T, host string, opts ClientOptions) *Client {
+	t.Helper()
+
+	token := "test-token"
+	client, err := NewClient(host, &token, "test", opts)
+	if err != nil {
+		t.Fatalf("NewClient returned error: %v", err)
+	}
+
+	return client
+}
@@ -0,0 +1,39 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+)
+
+// ErrReadOnly is returned, before anything is sent, for calls that would change something while
+// the client is read-only.
+var ErrReadOnly = errors.New("the WarpStream provider is read-only")
+
+// mutatingPrefixes are the prefixes of the endpoints that change something. The ACL endpoints
+// are the exception, since they are named by a trailing verb instead.
+var mutatingPrefixes = []string{"create_", "update_", "delete_", "rename_"}
+
+// mutatingEndpoint reports whether the endpoint at urlPath changes anything.
+func mutatingEndpoint(urlPath string) bool {
+	endpoint := path.Base(urlPath)
+	switch endpoint {
+	case "change_pipeline_state", "create", "delete":
+		return true
+	}
+	for _, prefix := range mutatingPrefixes {
+		if strings.HasPrefix(endpoint, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// checkReadOnly fails calls to mutating endpoints while the client is read-only.
+func (c *Client) checkReadOnly(urlPath string) error {
+	if !c.ReadOnly || !mutatingEndpoint(urlPath) {
+		return nil
+	}
+	return fmt.Errorf("%w: refusing to call %s. Unset read_only and WARPSTREAM_READ_ONLY to make changes", ErrReadOnly, urlPath)
+}
@@ -0,0 +1,65 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+)
+
+func TestMutatingEndpoint(t *testing.T) {
+	t.Parallel()
+
+	for path, want := range map[string]bool{
+		"/api/v1/create_topic":                       true,
+		"/api/v1/update_virtual_cluster_tags":        true,
+		"/api/v1/delete_workspace":                   true,
+		"/api/v1/rename_virtual_cluster":             true,
+		"/api/v1/change_pipeline_state":              true,
+		"/api/v1/virtual_clusters/acls/create":       true,
+		"/api/v1/virtual_clusters/acls/delete":       true,
+		"/api/v1/virtual_clusters/acls/list":         false,
+		"/api/v1/describe_virtual_cluster":           false,
+		"/api/v1/list_topics":                        false,
+		"/api/v1/get_events_state":                   false,
+		"/api/v1/describe_virtual_cluster_tags":      false,
+		"/api/v1/list_workload_identity_federations": false,
+	} {
+		if got := mutatingEndpoint(path); got != want {
+			t.Errorf("mutatingEndpoint(%q) = %v, want %v", path, got, want)
+		}
+	}
+}
+
+func TestReadOnlyClientRefusesMutations(t *testing.T) {
+	t.Parallel()
+
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		_, _ = w.Write([]byte(`{"workspaces":[]}`))
+	}))
+	defer server.Close()
+
+	client := newTestClientWithOptions(t, server.URL, DefaultClientOptions())
+	client.ReadOnly = true
+
+	err := client.CreateTopic(t.Context(), "vci_test", "orders", 1, nil)
+	if !errors.Is(err, ErrReadOnly) {
+		t.Fatalf("expected ErrReadOnly from CreateTopic, got %v", err)
+	}
+	if err := client.RenameWorkspace(t.Context(), "wi_test", "renamed"); !errors.Is(err, ErrReadOnly) {
+		t.Fatalf("expected ErrReadOnly from RenameWorkspace, got %v", err)
+	}
+	if n := requests.Load(); n != 0 {
+		t.Fatalf("expected no requests to be sent, got %d", n)
+	}
+
+	if _, err := client.GetWorkspaces(t.Context()); err != nil {
+		t.Fatalf("GetWorkspaces returned error: %v", err)
+	}
+	if n := requests.Load(); n != 1 {
+		t.Fatalf("expected reads to be sent, got %d requests", n)
+	}
+}
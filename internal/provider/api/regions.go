@@ -0,0 +1,35 @@
+package api
+
+import (
+	"fmt"
+	"slices"
+)
+
+// controlPlaneRegions are the regions with a WarpStream control plane. Each serves its own API at
+// api.prod.<region>.warpstream.com, the scheme of the default HostURL in us-east-1, and hosts the
+// clusters whose cloud region it is.
+//
+// The provider cannot discover control planes, so the table is maintained by hand: add a
+// region here when a new control plane opens. Endpoints outside the table can still be reached
+// with base_url.
+var controlPlaneRegions = []string{
+	"ap-southeast-1",
+	"eu-central-1",
+	"eu-west-1",
+	"us-east-1",
+	"us-west-2",
+}
+
+// ControlPlaneRegions returns the regions RegionHostURL accepts.
+func ControlPlaneRegions() []string {
+	return slices.Clone(controlPlaneRegions)
+}
+
+// RegionHostURL returns the API base URL of a region's control plane. ok is false for regions
+// without one.
+func RegionHostURL(region string) (string, bool) {
+	if !slices.Contains(controlPlaneRegions, region) {
+		return "", false
+	}
+	return fmt.Sprintf("https://api.prod.%s.warpstream.com/api/v1", region), true
+}
@@ -0,0 +1,18 @@
+package api
+
+import "testing"
+
+func TestRegionHostURL(t *testing.T) {
+	t.Parallel()
+
+	// The default host is the us-east-1 control plane.
+	if got, ok := RegionHostURL("us-east-1"); !ok || got != HostURL {
+		t.Fatalf("expected us-east-1 to map to %s, got %q (ok=%t)", HostURL, got, ok)
+	}
+	if got, ok := RegionHostURL("eu-west-1"); !ok || got != "https://api.prod.eu-west-1.warpstream.com/api/v1" {
+		t.Fatalf("unexpected eu-west-1 host %q (ok=%t)", got, ok)
+	}
+	if _, ok := RegionHostURL("mars-north-1"); ok {
+		t.Fatal("expected an unknown region to be rejected")
+	}
+}
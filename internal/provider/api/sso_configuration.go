@@ -2,6 +2,7 @@ package api
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -44,7 +45,7 @@ type SSOConfigurationGetResponseRequest struct {
 }
 
 // CreateSSOConfiguration - Create new SSO configuration - there cannot be more than one per tenant.
-func (c *Client) CreateSSOConfiguration(
+func (c *Client) CreateSSOConfiguration(ctx context.Context,
 	createRequest SSOConfigurationCreateRequest,
 ) (string, error) {
 	payload, err := json.Marshal(createRequest)
@@ -52,7 +53,7 @@ func (c *Client) CreateSSOConfiguration(
 		return "", fmt.Errorf("failed to marshal SSO configuration create request: %w", err)
 	}
 
-	req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("%s/create_sso_configuration", c.HostURL), bytes.NewReader(payload))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/create_sso_configuration", c.HostURL), bytes.NewReader(payload))
 	if err != nil {
 		return "", fmt.Errorf("failed to create SSO configuration create request: %w", err)
 	}
@@ -74,13 +75,13 @@ func (c *Client) CreateSSOConfiguration(
 }
 
 // DeleteSSOConfiguration - Delete tenant's sso configuration.
-func (c *Client) DeleteSSOConfiguration(id string) error {
+func (c *Client) DeleteSSOConfiguration(ctx context.Context, id string) error {
 	payload, err := json.Marshal(SSOConfigurationDeleteRequest{SSOConnectionID: id})
 	if err != nil {
 		return fmt.Errorf("failed to marshal SSO configuration delete request: %w", err)
 	}
 
-	req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("%s/delete_sso_configuration", c.HostURL), bytes.NewReader(payload))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/delete_sso_configuration", c.HostURL), bytes.NewReader(payload))
 	if err != nil {
 		return fmt.Errorf("failed to create SSO configuration delete request: %w", err)
 	}
@@ -98,13 +99,13 @@ func (c *Client) DeleteSSOConfiguration(id string) error {
 }
 
 // UpdateSSOConfiguration - Update SSO configuration.
-func (c *Client) UpdateSSOConfiguration(updateRequest SSOConfigurationUpdateRequest) error {
+func (c *Client) UpdateSSOConfiguration(ctx context.Context, updateRequest SSOConfigurationUpdateRequest) error {
 	payload, err := json.Marshal(updateRequest)
 	if err != nil {
 		return fmt.Errorf("failed to marshal SSO configuration update request: %w", err)
 	}
 
-	req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("%s/update_sso_configuration", c.HostURL), bytes.NewReader(payload))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/update_sso_configuration", c.HostURL), bytes.NewReader(payload))
 	if err != nil {
 		return fmt.Errorf("failed to create SSO configuration update request: %w", err)
 	}
@@ -122,8 +123,8 @@ func (c *Client) UpdateSSOConfiguration(updateRequest SSOConfigurationUpdateRequ
 }
 
 // GetSSOConfiguration - Return the current SSO configuration for the tenant if it matches the provided ID.
-func (c *Client) GetSSOConfiguration(ssoConfigurationID string) (*SSOConfiguration, error) {
-	req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("%s/get_sso_configuration", c.HostURL), nil)
+func (c *Client) GetSSOConfiguration(ctx context.Context, ssoConfigurationID string) (*SSOConfiguration, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/get_sso_configuration", c.HostURL), nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create SSO configuration get request: %w", err)
 	}
@@ -151,8 +152,8 @@ func (c *Client) GetSSOConfiguration(ssoConfigurationID string) (*SSOConfigurati
 }
 
 // GetSSOConfigurationWithoutID - Return the current SSO configuration for the tenant. Can return nil, nil if none exists.
-func (c *Client) GetSSOConfigurationWithoutID() (*SSOConfiguration, error) {
-	req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("%s/get_sso_configuration", c.HostURL), nil)
+func (c *Client) GetSSOConfigurationWithoutID(ctx context.Context) (*SSOConfiguration, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/get_sso_configuration", c.HostURL), nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create SSO configuration get request: %w", err)
 	}
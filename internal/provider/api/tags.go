@@ -2,12 +2,31 @@ package api
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
+	"slices"
+	"strings"
 )
 
+// IgnoreTags names tags that systems outside Terraform manage on virtual clusters.
+type IgnoreTags struct {
+	Keys        []string
+	KeyPrefixes []string
+}
+
+// Matches reports whether key is one of the ignored keys or starts with an ignored prefix.
+func (i IgnoreTags) Matches(key string) bool {
+	if slices.Contains(i.Keys, key) {
+		return true
+	}
+	return slices.ContainsFunc(i.KeyPrefixes, func(prefix string) bool {
+		return strings.HasPrefix(key, prefix)
+	})
+}
+
 type TagsDescribeRequest struct {
 	VirtualClusterID string `json:"virtual_cluster_id"`
 }
@@ -21,18 +40,18 @@ type TagsUpdateRequest struct {
 	Tags             map[string]string `json:"tags"`
 }
 
-func (c *Client) GetTags(vc VirtualCluster) (map[string]string, error) {
+func (c *Client) GetTags(ctx context.Context, vc VirtualCluster) (map[string]string, error) {
 	payload, err := json.Marshal(TagsDescribeRequest{VirtualClusterID: vc.ID})
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("%s/describe_virtual_cluster_tags", c.HostURL), bytes.NewReader(payload))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/describe_virtual_cluster_tags", c.HostURL), bytes.NewReader(payload))
 	if err != nil {
 		return nil, err
 	}
 
-	body, err := c.doRequest(req, nil)
+	body, err := c.doDescribe(req, vc.ID)
 	if err != nil {
 		return nil, err
 	}
@@ -49,18 +68,19 @@ func (c *Client) GetTags(vc VirtualCluster) (map[string]string, error) {
 	return res.Tags, nil
 }
 
-func (c *Client) UpdateTags(tags map[string]string, vc VirtualCluster) error {
+func (c *Client) UpdateTags(ctx context.Context, tags map[string]string, vc VirtualCluster) error {
 	payload, err := json.Marshal(TagsUpdateRequest{VirtualClusterID: vc.ID, Tags: tags})
 	if err != nil {
 		return err
 	}
 
-	req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("%s/update_virtual_cluster_tags", c.HostURL), bytes.NewReader(payload))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/update_virtual_cluster_tags", c.HostURL), bytes.NewReader(payload))
 	if err != nil {
 		return err
 	}
 
 	body, err := c.doRequest(req, nil)
+	c.describeCache.invalidate(vc.ID)
 	if err != nil {
 		return err
 	}
@@ -2,9 +2,13 @@ package api
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"maps"
 	"net/http"
+	"sync"
 )
 
 type Topic struct {
@@ -43,7 +47,16 @@ type TopicDeleteRequest struct {
 	TopicName        string `json:"topic_name"`
 }
 
-func (c *Client) CreateTopic(virtualClusterID string, topicName string, partitionCount int, configs map[string]*string) error {
+type TopicListRequest struct {
+	VirtualClusterID string `json:"virtual_cluster_id"`
+	IncludeConfigs   bool   `json:"include_configs"`
+}
+
+type TopicListResponse struct {
+	Topics []Topic `json:"topics"`
+}
+
+func (c *Client) CreateTopic(ctx context.Context, virtualClusterID string, topicName string, partitionCount int, configs map[string]*string) error {
 	payload, err := json.Marshal(TopicCreateRequest{
 		VirtualClusterID: virtualClusterID,
 		TopicName:        topicName,
@@ -54,12 +67,13 @@ func (c *Client) CreateTopic(virtualClusterID string, topicName string, partitio
 		return err
 	}
 
-	req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("%s/create_topic", c.HostURL), bytes.NewReader(payload))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/create_topic", c.HostURL), bytes.NewReader(payload))
 	if err != nil {
 		return err
 	}
 
 	_, err = c.doRequest(req, nil)
+	c.topicsCache.markStale(virtualClusterID, topicName)
 	if err != nil {
 		return fmt.Errorf("error doing creating topic request: %w", err)
 	}
@@ -67,7 +81,7 @@ func (c *Client) CreateTopic(virtualClusterID string, topicName string, partitio
 	return nil
 }
 
-func (c *Client) DescribeTopic(virtualClusterID string, topicName string) (*Topic, error) {
+func (c *Client) DescribeTopic(ctx context.Context, virtualClusterID string, topicName string) (*Topic, error) {
 	payload, err := json.Marshal(TopicDescribeRequest{
 		VirtualClusterID: virtualClusterID,
 		TopicName:        topicName,
@@ -76,13 +90,16 @@ func (c *Client) DescribeTopic(virtualClusterID string, topicName string) (*Topi
 		return nil, err
 	}
 
-	req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("%s/describe_topic", c.HostURL), bytes.NewReader(payload))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/describe_topic", c.HostURL), bytes.NewReader(payload))
 	if err != nil {
 		return nil, err
 	}
 
 	body, err := c.doRequest(req, nil)
 	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			c.topicsCache.remove(virtualClusterID, topicName)
+		}
 		return nil, fmt.Errorf("error doing describe topic request: %w", err)
 	}
 
@@ -92,15 +109,87 @@ func (c *Client) DescribeTopic(virtualClusterID string, topicName string) (*Topi
 		return nil, err
 	}
 
-	return &Topic{
+	topic := Topic{
 		VirtualClusterID: virtualClusterID,
 		TopicName:        topicName,
 		PartitionCount:   res.PartitionCount,
 		Configs:          res.Configs,
-	}, nil
+	}
+	c.topicsCache.put(topic)
+
+	return &topic, nil
+}
+
+// GetTopic returns a topic and its configs. Unlike DescribeTopic it is served from the topics
+// cache, which is seeded by listing every topic of the virtual cluster once.
+func (c *Client) GetTopic(ctx context.Context, virtualClusterID string, topicName string) (*Topic, error) {
+	topic, cached, found := c.topicsCache.getTopic(virtualClusterID, topicName)
+	if cached {
+		if !found {
+			return nil, ErrNotFound
+		}
+
+		return &topic, nil
+	}
+
+	// The topic changed since the cache was seeded, so only describe that one topic.
+	if c.topicsCache.seeded(virtualClusterID) {
+		return c.DescribeTopic(ctx, virtualClusterID, topicName)
+	}
+
+	topics, err := c.ListTopics(ctx, virtualClusterID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list topics: %w", err)
+	}
+
+	for _, topic := range topics {
+		if topic.TopicName == topicName {
+			return &topic, nil
+		}
+	}
+
+	return nil, ErrNotFound
 }
 
-func (c *Client) UpdateTopic(virtualClusterID string, topicName string, partitionCount *int, configs map[string]*string) error {
+// ListTopics retrieves all topics of a virtual cluster, with their configs.
+func (c *Client) ListTopics(ctx context.Context, virtualClusterID string) ([]Topic, error) {
+	if topics, ok := c.topicsCache.get(virtualClusterID); ok {
+		return topics, nil
+	}
+
+	payload, err := json.Marshal(TopicListRequest{
+		VirtualClusterID: virtualClusterID,
+		IncludeConfigs:   true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/list_topics", c.HostURL), bytes.NewReader(payload))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error doing list topics request: %w", err)
+	}
+
+	res := TopicListResponse{}
+	err = json.Unmarshal(body, &res)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range res.Topics {
+		res.Topics[i].VirtualClusterID = virtualClusterID
+	}
+
+	c.topicsCache.set(virtualClusterID, res.Topics)
+	return cloneTopics(res.Topics), nil
+}
+
+func (c *Client) UpdateTopic(ctx context.Context, virtualClusterID string, topicName string, partitionCount *int, configs map[string]*string) error {
 	payload, err := json.Marshal(TopicUpdateRequest{
 		VirtualClusterID: virtualClusterID,
 		TopicName:        topicName,
@@ -111,12 +200,13 @@ func (c *Client) UpdateTopic(virtualClusterID string, topicName string, partitio
 		return err
 	}
 
-	req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("%s/update_topic", c.HostURL), bytes.NewReader(payload))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/update_topic", c.HostURL), bytes.NewReader(payload))
 	if err != nil {
 		return err
 	}
 
 	_, err = c.doRequest(req, nil)
+	c.topicsCache.markStale(virtualClusterID, topicName)
 	if err != nil {
 		return fmt.Errorf("error doing update topic request: %w", err)
 	}
@@ -124,7 +214,7 @@ func (c *Client) UpdateTopic(virtualClusterID string, topicName string, partitio
 	return nil
 }
 
-func (c *Client) DeleteTopic(virtualClusterID string, topicName string) error {
+func (c *Client) DeleteTopic(ctx context.Context, virtualClusterID string, topicName string) error {
 	payload, err := json.Marshal(TopicDeleteRequest{
 		VirtualClusterID: virtualClusterID,
 		TopicName:        topicName,
@@ -133,15 +223,151 @@ func (c *Client) DeleteTopic(virtualClusterID string, topicName string) error {
 		return err
 	}
 
-	req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("%s/delete_topic", c.HostURL), bytes.NewReader(payload))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/delete_topic", c.HostURL), bytes.NewReader(payload))
 	if err != nil {
 		return err
 	}
 
 	_, err = c.doRequest(req, nil)
 	if err != nil {
+		c.topicsCache.markStale(virtualClusterID, topicName)
 		return fmt.Errorf("error doing delete topic request: %w", err)
 	}
 
+	c.topicsCache.remove(virtualClusterID, topicName)
+
 	return nil
 }
+
+// topicsCache is the topic equivalent of aclsCache: refreshing thousands of topics one
+// describe_topic call at a time is slow, so the cache is seeded by a single call listing every
+// topic of the virtual cluster with its configs.
+//
+// Unlike ACLs, topics are mutated in place, and the control plane fills in configs we didn't set,
+// so a created or updated topic is marked stale rather than patched. A stale topic is described on
+// its own the next time it is read, which puts it back in the cache without listing again.
+type topicsCache struct {
+	mu          sync.Mutex
+	entriesByVC map[string]topicsCacheEntry
+}
+
+type topicsCacheEntry struct {
+	topicsByName map[string]Topic
+	stale        map[string]struct{}
+}
+
+// get returns every topic of the virtual cluster, if the cache holds all of them.
+func (c *topicsCache) get(vcID string) ([]Topic, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entriesByVC[vcID]
+	if !ok || len(entry.stale) > 0 {
+		return nil, false
+	}
+
+	topics := make([]Topic, 0, len(entry.topicsByName))
+	for _, topic := range entry.topicsByName {
+		topics = append(topics, cloneTopic(topic))
+	}
+	return topics, true
+}
+
+// getTopic returns a topic from the cache. cached is false if the virtual cluster isn't cached or
+// the topic is stale; found is false if the topic doesn't exist.
+func (c *topicsCache) getTopic(vcID, topicName string) (topic Topic, cached bool, found bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entriesByVC[vcID]
+	if !ok {
+		return Topic{}, false, false
+	}
+
+	if _, stale := entry.stale[topicName]; stale {
+		return Topic{}, false, false
+	}
+
+	topic, found = entry.topicsByName[topicName]
+	return cloneTopic(topic), true, found
+}
+
+func (c *topicsCache) seeded(vcID string) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	_, ok := c.entriesByVC[vcID]
+	return ok
+}
+
+func (c *topicsCache) set(vcID string, topics []Topic) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.entriesByVC == nil {
+		c.entriesByVC = make(map[string]topicsCacheEntry)
+	}
+
+	topicsByName := make(map[string]Topic, len(topics))
+	for _, topic := range topics {
+		topicsByName[topic.TopicName] = cloneTopic(topic)
+	}
+
+	c.entriesByVC[vcID] = topicsCacheEntry{
+		topicsByName: topicsByName,
+		stale:        make(map[string]struct{}),
+	}
+}
+
+// put stores a freshly described topic, if its virtual cluster is cached.
+func (c *topicsCache) put(topic Topic) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entriesByVC[topic.VirtualClusterID]
+	if !ok {
+		return
+	}
+
+	entry.topicsByName[topic.TopicName] = cloneTopic(topic)
+	delete(entry.stale, topic.TopicName)
+}
+
+func (c *topicsCache) markStale(vcID, topicName string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entriesByVC[vcID]
+	if !ok {
+		return
+	}
+
+	delete(entry.topicsByName, topicName)
+	entry.stale[topicName] = struct{}{}
+}
+
+func (c *topicsCache) remove(vcID, topicName string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entriesByVC[vcID]
+	if !ok {
+		return
+	}
+
+	delete(entry.topicsByName, topicName)
+	delete(entry.stale, topicName)
+}
+
+func cloneTopic(topic Topic) Topic {
+	topic.Configs = maps.Clone(topic.Configs)
+	return topic
+}
+
+func cloneTopics(topics []Topic) []Topic {
+	cloned := make([]Topic, len(topics))
+	for i, topic := range topics {
+		cloned[i] = cloneTopic(topic)
+	}
+	return cloned
+}
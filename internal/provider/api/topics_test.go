@@ -0,0 +1,184 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+)
+
+func TestClientGetTopicServesFromListing(t *testing.T) {
+	t.Parallel()
+
+	state := newTopicTestServerState("vc-1", "orders", "payments", "refunds")
+	server := newTopicTestServer(state)
+	defer server.Close()
+
+	client := newACLTestClient(t, server.URL)
+
+	for _, name := range []string{"orders", "payments", "refunds"} {
+		topic, err := client.GetTopic(t.Context(), "vc-1", name)
+		if err != nil {
+			t.Fatalf("GetTopic(%s) returned error: %v", name, err)
+		}
+
+		if topic.TopicName != name || topic.VirtualClusterID != "vc-1" || topic.PartitionCount != 3 {
+			t.Fatalf("unexpected topic %+v", topic)
+		}
+	}
+
+	if _, err := client.GetTopic(t.Context(), "vc-1", "missing"); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected ErrNotFound for a topic missing from the listing, got %v", err)
+	}
+
+	state.mu.Lock()
+	defer state.mu.Unlock()
+
+	if state.listCalls != 1 || state.describeCalls != 0 {
+		t.Fatalf("expected 1 list call and no describes, got %d lists and %d describes", state.listCalls, state.describeCalls)
+	}
+}
+
+func TestClientTopicMutationsKeepCacheCurrent(t *testing.T) {
+	t.Parallel()
+
+	state := newTopicTestServerState("vc-1", "orders", "payments")
+	server := newTopicTestServer(state)
+	defer server.Close()
+
+	client := newACLTestClient(t, server.URL)
+
+	if _, err := client.ListTopics(t.Context(), "vc-1"); err != nil {
+		t.Fatalf("ListTopics returned error: %v", err)
+	}
+
+	if err := client.CreateTopic(t.Context(), "vc-1", "refunds", 6, nil); err != nil {
+		t.Fatalf("CreateTopic returned error: %v", err)
+	}
+
+	created, err := client.GetTopic(t.Context(), "vc-1", "refunds")
+	if err != nil {
+		t.Fatalf("GetTopic after create returned error: %v", err)
+	}
+
+	if created.PartitionCount != 6 {
+		t.Fatalf("expected the created topic to have 6 partitions, got %d", created.PartitionCount)
+	}
+
+	partitions := 12
+	if err := client.UpdateTopic(t.Context(), "vc-1", "orders", &partitions, nil); err != nil {
+		t.Fatalf("UpdateTopic returned error: %v", err)
+	}
+
+	updated, err := client.GetTopic(t.Context(), "vc-1", "orders")
+	if err != nil {
+		t.Fatalf("GetTopic after update returned error: %v", err)
+	}
+
+	if updated.PartitionCount != 12 {
+		t.Fatalf("expected the updated topic to have 12 partitions, got %d", updated.PartitionCount)
+	}
+
+	if err := client.DeleteTopic(t.Context(), "vc-1", "payments"); err != nil {
+		t.Fatalf("DeleteTopic returned error: %v", err)
+	}
+
+	if _, err := client.GetTopic(t.Context(), "vc-1", "payments"); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected ErrNotFound after delete, got %v", err)
+	}
+
+	// Every stale topic has been described again, so the listing is whole again.
+	topics, err := client.ListTopics(t.Context(), "vc-1")
+	if err != nil {
+		t.Fatalf("ListTopics returned error: %v", err)
+	}
+
+	if len(topics) != 2 {
+		t.Fatalf("expected 2 topics, got %v", topics)
+	}
+
+	state.mu.Lock()
+	defer state.mu.Unlock()
+
+	if state.listCalls != 1 {
+		t.Fatalf("expected mutations not to trigger another list call, got %d", state.listCalls)
+	}
+
+	if state.describeCalls != 2 {
+		t.Fatalf("expected the created and updated topics to be described once each, got %d", state.describeCalls)
+	}
+}
+
+type topicTestServerState struct {
+	mu            sync.Mutex
+	vcID          string
+	topics        map[string]Topic
+	listCalls     int
+	describeCalls int
+}
+
+func newTopicTestServerState(vcID string, names ...string) *topicTestServerState {
+	state := &topicTestServerState{
+		vcID:   vcID,
+		topics: make(map[string]Topic, len(names)),
+	}
+
+	for _, name := range names {
+		state.topics[name] = Topic{TopicName: name, PartitionCount: 3, Configs: map[string]*string{}}
+	}
+
+	return state
+}
+
+func newTopicTestServer(state *topicTestServerState) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req TopicUpdateRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		state.mu.Lock()
+		defer state.mu.Unlock()
+
+		if req.VirtualClusterID != state.vcID {
+			http.NotFound(w, r)
+			return
+		}
+
+		switch r.URL.Path {
+		case "/list_topics":
+			state.listCalls++
+			res := TopicListResponse{}
+			for _, topic := range state.topics {
+				res.Topics = append(res.Topics, topic)
+			}
+			writeACLTestResponse(w, res)
+		case "/describe_topic":
+			state.describeCalls++
+			topic, ok := state.topics[req.TopicName]
+			if !ok {
+				http.NotFound(w, r)
+				return
+			}
+			writeACLTestResponse(w, TopicDescribeResponse{PartitionCount: topic.PartitionCount, Configs: topic.Configs})
+		case "/create_topic":
+			state.topics[req.TopicName] = Topic{TopicName: req.TopicName, PartitionCount: *req.PartitionCount, Configs: req.Configs}
+			writeACLTestResponse(w, struct{}{})
+		case "/update_topic":
+			topic := state.topics[req.TopicName]
+			if req.PartitionCount != nil {
+				topic.PartitionCount = *req.PartitionCount
+			}
+			state.topics[req.TopicName] = topic
+			writeACLTestResponse(w, struct{}{})
+		case "/delete_topic":
+			delete(state.topics, req.TopicName)
+			writeACLTestResponse(w, struct{}{})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+}
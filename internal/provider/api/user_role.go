@@ -2,6 +2,7 @@ package api
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -35,13 +36,13 @@ type UserRoleDeleteRequest struct {
 }
 
 // CreateUserRole - Create new User Role.
-func (c *Client) CreateUserRole(name string, grants []AccessGrant) (string, error) {
+func (c *Client) CreateUserRole(ctx context.Context, name string, grants []AccessGrant) (string, error) {
 	payload, err := json.Marshal(UserRoleCreateRequest{Name: name, AccessGrants: grants})
 	if err != nil {
 		return "", err
 	}
 
-	req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("%s/create_user_role", c.HostURL), bytes.NewReader(payload))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/create_user_role", c.HostURL), bytes.NewReader(payload))
 	if err != nil {
 		return "", err
 	}
@@ -62,13 +63,13 @@ func (c *Client) CreateUserRole(name string, grants []AccessGrant) (string, erro
 	return res.ID, nil
 }
 
-func (c *Client) UpdateUserRole(id string, name string, grants []AccessGrant) error {
+func (c *Client) UpdateUserRole(ctx context.Context, id string, name string, grants []AccessGrant) error {
 	payload, err := json.Marshal(UserRoleUpdateRequest{ID: id, Name: name, AccessGrants: grants})
 	if err != nil {
 		return err
 	}
 
-	req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("%s/update_user_role", c.HostURL), bytes.NewReader(payload))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/update_user_role", c.HostURL), bytes.NewReader(payload))
 	if err != nil {
 		return err
 	}
@@ -86,13 +87,13 @@ func (c *Client) UpdateUserRole(id string, name string, grants []AccessGrant) er
 }
 
 // DeleteUserRole - Delete a User Role.
-func (c *Client) DeleteUserRole(id string) error {
+func (c *Client) DeleteUserRole(ctx context.Context, id string) error {
 	payload, err := json.Marshal(UserRoleDeleteRequest{ID: id})
 	if err != nil {
 		return err
 	}
 
-	req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("%s/delete_user_role", c.HostURL), bytes.NewReader(payload))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/delete_user_role", c.HostURL), bytes.NewReader(payload))
 	if err != nil {
 		return err
 	}
@@ -110,8 +111,8 @@ func (c *Client) DeleteUserRole(id string) error {
 }
 
 // getUserRoles - Returns list of User Roles.
-func (c *Client) getUserRoles() ([]UserRole, error) {
-	req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("%s/list_user_roles", c.HostURL), nil)
+func (c *Client) getUserRoles(ctx context.Context) ([]UserRole, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/list_user_roles", c.HostURL), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -131,8 +132,8 @@ func (c *Client) getUserRoles() ([]UserRole, error) {
 }
 
 // GetUserRole - Return one Role.
-func (c *Client) GetUserRole(roleID string) (*UserRole, error) {
-	roles, err := c.getUserRoles()
+func (c *Client) GetUserRole(ctx context.Context, roleID string) (*UserRole, error) {
+	roles, err := c.getUserRoles(ctx)
 
 	if err != nil {
 		return nil, fmt.Errorf("failed to get user roles list: %w", err)
@@ -147,8 +148,8 @@ func (c *Client) GetUserRole(roleID string) (*UserRole, error) {
 	return nil, ErrNotFound
 }
 
-func (c *Client) FindUserRole(name string) (*UserRole, error) {
-	roles, err := c.getUserRoles()
+func (c *Client) FindUserRole(ctx context.Context, name string) (*UserRole, error) {
+	roles, err := c.getUserRoles(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get user roles list: %w", err)
 	}
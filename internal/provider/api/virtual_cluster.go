@@ -2,11 +2,13 @@ package api
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
 	"strings"
+	"time"
 )
 
 const (
@@ -97,18 +99,18 @@ type VirtualClusterDeleteRequest struct {
 }
 
 // GetVirtualCluster - Returns description of virtual cluster.
-func (c *Client) GetVirtualCluster(id string) (*VirtualCluster, error) {
+func (c *Client) GetVirtualCluster(ctx context.Context, id string) (*VirtualCluster, error) {
 	payload, err := json.Marshal(VirtualClusterDescribeRequest{ID: id})
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("%s/describe_virtual_cluster", c.HostURL), bytes.NewReader(payload))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/describe_virtual_cluster", c.HostURL), bytes.NewReader(payload))
 	if err != nil {
 		return nil, err
 	}
 
-	body, err := c.doRequest(req, nil)
+	body, err := c.doDescribe(req, id)
 	if err != nil {
 		return nil, err
 	}
@@ -132,7 +134,24 @@ type ClusterParameters struct {
 }
 
 // CreateVirtualCluster - Create new virtual cluster.
-func (c *Client) CreateVirtualCluster(name string, opts ClusterParameters) (*VirtualCluster, error) {
+func (c *Client) CreateVirtualCluster(ctx context.Context, name string, opts ClusterParameters) (*VirtualCluster, error) {
+	start := time.Now()
+	return createIdempotently(ctx,
+		func(ctx context.Context) (*VirtualCluster, error) {
+			return c.createVirtualCluster(ctx, name, opts)
+		},
+		func(ctx context.Context) (*VirtualCluster, bool, error) {
+			// Only a cluster created by this call is recovered, not one that already had the name.
+			vc, found, err := lookupResult(c.FindVirtualCluster(ctx, name))
+			if !found || !createdSince(vc.CreatedAt, start) {
+				return nil, false, err
+			}
+			return vc, true, nil
+		},
+	)
+}
+
+func (c *Client) createVirtualCluster(ctx context.Context, name string, opts ClusterParameters) (*VirtualCluster, error) {
 	var trimmed string
 	switch opts.Type {
 	case VirtualClusterTypeSchemaRegistry:
@@ -156,7 +175,7 @@ func (c *Client) CreateVirtualCluster(name string, opts ClusterParameters) (*Vir
 		return nil, err
 	}
 
-	req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("%s/create_virtual_cluster", c.HostURL), bytes.NewReader(payload))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/create_virtual_cluster", c.HostURL), bytes.NewReader(payload))
 	if err != nil {
 		return nil, err
 	}
@@ -184,7 +203,7 @@ func (c *Client) CreateVirtualCluster(name string, opts ClusterParameters) (*Vir
 	return &vc, nil
 }
 
-func (c *Client) RenameVirtualCluster(id string, newName string) error {
+func (c *Client) RenameVirtualCluster(ctx context.Context, id string, newName string) error {
 	newNameParts := strings.Split(newName, "vcn_")
 	if len(newNameParts) < 2 {
 		// Should never happen because of schema-level validation.
@@ -196,12 +215,13 @@ func (c *Client) RenameVirtualCluster(id string, newName string) error {
 		return err
 	}
 
-	req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("%s/rename_virtual_cluster", c.HostURL), bytes.NewReader(payload))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/rename_virtual_cluster", c.HostURL), bytes.NewReader(payload))
 	if err != nil {
 		return err
 	}
 
 	body, err := c.doRequest(req, nil)
+	c.describeCache.invalidate(id)
 	if err != nil {
 		return err
 	}
@@ -214,18 +234,19 @@ func (c *Client) RenameVirtualCluster(id string, newName string) error {
 }
 
 // DeleteVirtualCluster - Delete a virtual cluster.
-func (c *Client) DeleteVirtualCluster(id string, name string) error {
+func (c *Client) DeleteVirtualCluster(ctx context.Context, id string, name string) error {
 	payload, err := json.Marshal(VirtualClusterDeleteRequest{ID: id, Name: name})
 	if err != nil {
 		return err
 	}
 
-	req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("%s/delete_virtual_cluster", c.HostURL), bytes.NewReader(payload))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/delete_virtual_cluster", c.HostURL), bytes.NewReader(payload))
 	if err != nil {
 		return err
 	}
 
 	body, err := c.doRequest(req, nil)
+	c.describeCache.invalidate(id)
 	if err != nil {
 		return err
 	}
@@ -238,8 +259,8 @@ func (c *Client) DeleteVirtualCluster(id string, name string) error {
 }
 
 // GetVirtualClusters - Returns list of virtual clusters.
-func (c *Client) GetVirtualClusters() ([]VirtualCluster, error) {
-	req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("%s/list_virtual_clusters", c.HostURL), nil)
+func (c *Client) GetVirtualClusters(ctx context.Context) ([]VirtualCluster, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/list_virtual_clusters", c.HostURL), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -259,8 +280,8 @@ func (c *Client) GetVirtualClusters() ([]VirtualCluster, error) {
 }
 
 // FindVirtualCluster - Returns virtual cluster with given name.
-func (c *Client) FindVirtualCluster(name string) (*VirtualCluster, error) {
-	vcs, err := c.GetVirtualClusters()
+func (c *Client) FindVirtualCluster(ctx context.Context, name string) (*VirtualCluster, error) {
+	vcs, err := c.GetVirtualClusters(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -275,8 +296,8 @@ func (c *Client) FindVirtualCluster(name string) (*VirtualCluster, error) {
 }
 
 // GetDefaultCluster - Return the default virtual cluster.
-func (c *Client) GetDefaultCluster() (*VirtualCluster, error) {
-	return c.FindVirtualCluster("vcn_default")
+func (c *Client) GetDefaultCluster(ctx context.Context) (*VirtualCluster, error) {
+	return c.FindVirtualCluster(ctx, "vcn_default")
 }
 
 type VirtualClusterUpdateTierRequest struct {
@@ -284,7 +305,7 @@ type VirtualClusterUpdateTierRequest struct {
 	Tier             string `json:"tier"`
 }
 
-func (c *Client) UpdateVirtualClusterTier(id string, tier string) error {
+func (c *Client) UpdateVirtualClusterTier(ctx context.Context, id string, tier string) error {
 	payload, err := json.Marshal(VirtualClusterUpdateTierRequest{
 		VirtualClusterID: id,
 		Tier:             tier,
@@ -293,12 +314,13 @@ func (c *Client) UpdateVirtualClusterTier(id string, tier string) error {
 		return err
 	}
 
-	req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("%s/update_virtual_cluster_tier", c.HostURL), bytes.NewReader(payload))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/update_virtual_cluster_tier", c.HostURL), bytes.NewReader(payload))
 	if err != nil {
 		return err
 	}
 
 	_, err = c.doRequest(req, nil)
+	c.describeCache.invalidate(id)
 	if err != nil {
 		return err
 	}
@@ -2,6 +2,7 @@ package api
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -56,18 +57,18 @@ type ConfigurationUpdate struct {
 }
 
 // GetConfiguration - Describe virtual cluster configuration.
-func (c *Client) GetConfiguration(vc VirtualCluster) (*VirtualClusterConfiguration, error) {
+func (c *Client) GetConfiguration(ctx context.Context, vc VirtualCluster) (*VirtualClusterConfiguration, error) {
 	payload, err := json.Marshal(ConfigurationDescribeRequest{VirtualClusterID: vc.ID})
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("%s/describe_virtual_cluster_configuration", c.HostURL), bytes.NewReader(payload))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/describe_virtual_cluster_configuration", c.HostURL), bytes.NewReader(payload))
 	if err != nil {
 		return nil, err
 	}
 
-	body, err := c.doRequest(req, nil)
+	body, err := c.doDescribe(req, vc.ID)
 	if err != nil {
 		return nil, err
 	}
@@ -82,18 +83,19 @@ func (c *Client) GetConfiguration(vc VirtualCluster) (*VirtualClusterConfigurati
 }
 
 // UpdateConfiguration - Update virtual cluster configuration.
-func (c *Client) UpdateConfiguration(cfg ConfigurationUpdate, vc VirtualCluster) error {
+func (c *Client) UpdateConfiguration(ctx context.Context, cfg ConfigurationUpdate, vc VirtualCluster) error {
 	payload, err := json.Marshal(ConfigurationUpdateRequest{VirtualClusterID: vc.ID, Configuration: cfg})
 	if err != nil {
 		return err
 	}
 
-	req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("%s/update_virtual_cluster_configuration", c.HostURL), bytes.NewReader(payload))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/update_virtual_cluster_configuration", c.HostURL), bytes.NewReader(payload))
 	if err != nil {
 		return err
 	}
 
 	body, err := c.doRequest(req, nil)
+	c.describeCache.invalidate(vc.ID)
 	if err != nil {
 		return err
 	}
@@ -2,6 +2,7 @@ package api
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -46,8 +47,24 @@ type CredentialsDeleteRequest struct {
 	VirtualClusterID string `json:"virtual_cluster_id"`
 }
 
-// CreateCredentials - Create new virtual cluster credentials.
-func (c *Client) CreateCredentials(name string, su bool, readOnly bool, importedPassword *string, vc VirtualCluster) (*VirtualClusterCredentials, error) {
+// CreateCredentials - Create new virtual cluster credentials. The password is only returned on
+// creation, so if an ambiguous failure left credentials behind they are deleted and created again.
+// Credentials that had the name before the create are never deleted.
+func (c *Client) CreateCredentials(ctx context.Context, name string, su bool, readOnly bool, importedPassword *string, vc VirtualCluster) (*VirtualClusterCredentials, error) {
+	existing := snapshotIDs(ctx, func(ctx context.Context) ([]string, error) {
+		return c.credentialIDsNamed(ctx, name, vc)
+	})
+	return createIdempotently(ctx,
+		func(ctx context.Context) (*VirtualClusterCredentials, error) {
+			return c.createCredentials(ctx, name, su, readOnly, importedPassword, vc)
+		},
+		func(ctx context.Context) (*VirtualClusterCredentials, bool, error) {
+			return nil, false, c.deleteOrphanedCredentials(ctx, name, vc, existing)
+		},
+	)
+}
+
+func (c *Client) createCredentials(ctx context.Context, name string, su bool, readOnly bool, importedPassword *string, vc VirtualCluster) (*VirtualClusterCredentials, error) {
 	payload, err := json.Marshal(CredentialsCreateRequest{
 		Name:             strings.TrimPrefix(name, "ccn_"),
 		VirtualClusterID: vc.ID,
@@ -59,7 +76,7 @@ func (c *Client) CreateCredentials(name string, su bool, readOnly bool, imported
 		return nil, err
 	}
 
-	req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("%s/create_virtual_cluster_credentials", c.HostURL), bytes.NewReader(payload))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/create_virtual_cluster_credentials", c.HostURL), bytes.NewReader(payload))
 	if err != nil {
 		return nil, err
 	}
@@ -86,14 +103,53 @@ func (c *Client) CreateCredentials(name string, su bool, readOnly bool, imported
 	return &vcc, nil
 }
 
+// credentialIDsNamed returns the IDs of the credentials of vc with the given name.
+func (c *Client) credentialIDsNamed(ctx context.Context, name string, vc VirtualCluster) ([]string, error) {
+	creds, err := c.GetCredentials(ctx, vc)
+	if err != nil {
+		return nil, err
+	}
+
+	name = strings.TrimPrefix(name, "ccn_")
+	var ids []string
+	for _, cred := range creds {
+		if strings.TrimPrefix(cred.Name, "ccn_") == name {
+			ids = append(ids, cred.ID)
+		}
+	}
+	return ids, nil
+}
+
+// deleteOrphanedCredentials deletes the credentials with the given name that aren't in existing,
+// which were left behind by an ambiguous create.
+func (c *Client) deleteOrphanedCredentials(ctx context.Context, name string, vc VirtualCluster, existing map[string]bool) error {
+	if existing == nil {
+		return errNoSnapshot
+	}
+
+	ids, err := c.credentialIDsNamed(ctx, name, vc)
+	if err != nil {
+		return err
+	}
+	for _, id := range ids {
+		if existing[id] {
+			continue
+		}
+		if err := c.DeleteCredentials(ctx, id, vc); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // DeleteCredentials - Delete virtual cluster credentials.
-func (c *Client) DeleteCredentials(id string, vc VirtualCluster) error {
+func (c *Client) DeleteCredentials(ctx context.Context, id string, vc VirtualCluster) error {
 	payload, err := json.Marshal(CredentialsDeleteRequest{ID: id, VirtualClusterID: vc.ID})
 	if err != nil {
 		return err
 	}
 
-	req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("%s/delete_virtual_cluster_credentials", c.HostURL), bytes.NewReader(payload))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/delete_virtual_cluster_credentials", c.HostURL), bytes.NewReader(payload))
 	if err != nil {
 		return err
 	}
@@ -111,12 +167,12 @@ func (c *Client) DeleteCredentials(id string, vc VirtualCluster) error {
 }
 
 // GetCredentials - Returns all virtual clusters credentials of a given Virtual Cluster (indexed by ID).
-func (c *Client) GetCredentials(vc VirtualCluster) (map[string]VirtualClusterCredentials, error) {
+func (c *Client) GetCredentials(ctx context.Context, vc VirtualCluster) (map[string]VirtualClusterCredentials, error) {
 	payload, err := json.Marshal(CredentialsListRequest{VirtualClusterID: vc.ID})
 	if err != nil {
 		return nil, err
 	}
-	req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("%s/list_virtual_cluster_credentials", c.HostURL), bytes.NewReader(payload))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/list_virtual_cluster_credentials", c.HostURL), bytes.NewReader(payload))
 	if err != nil {
 		return nil, err
 	}
@@ -2,6 +2,7 @@ package api
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -52,7 +53,7 @@ type deleteWorkloadIdentityFederationRequest struct {
 
 // CreateWorkloadIdentityFederation creates a federation binding and returns the created resource,
 // including the server-derived audience and creation timestamp.
-func (c *Client) CreateWorkloadIdentityFederation(fed WorkloadIdentityFederation) (*WorkloadIdentityFederation, error) {
+func (c *Client) CreateWorkloadIdentityFederation(ctx context.Context, fed WorkloadIdentityFederation) (*WorkloadIdentityFederation, error) {
 	payload, err := json.Marshal(createWorkloadIdentityFederationRequest{
 		VirtualClusterID:        fed.VirtualClusterID,
 		Name:                    fed.Name,
@@ -65,7 +66,7 @@ func (c *Client) CreateWorkloadIdentityFederation(fed WorkloadIdentityFederation
 		return nil, err
 	}
 
-	req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("%s/create_workload_identity_federation", c.HostURL), bytes.NewReader(payload))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/create_workload_identity_federation", c.HostURL), bytes.NewReader(payload))
 	if err != nil {
 		return nil, err
 	}
@@ -83,13 +84,13 @@ func (c *Client) CreateWorkloadIdentityFederation(fed WorkloadIdentityFederation
 }
 
 // ListWorkloadIdentityFederations returns all federation bindings for a virtual cluster.
-func (c *Client) ListWorkloadIdentityFederations(virtualClusterID string) ([]WorkloadIdentityFederation, error) {
+func (c *Client) ListWorkloadIdentityFederations(ctx context.Context, virtualClusterID string) ([]WorkloadIdentityFederation, error) {
 	payload, err := json.Marshal(listWorkloadIdentityFederationsRequest{VirtualClusterID: virtualClusterID})
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("%s/list_workload_identity_federations", c.HostURL), bytes.NewReader(payload))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/list_workload_identity_federations", c.HostURL), bytes.NewReader(payload))
 	if err != nil {
 		return nil, err
 	}
@@ -108,8 +109,8 @@ func (c *Client) ListWorkloadIdentityFederations(virtualClusterID string) ([]Wor
 
 // GetWorkloadIdentityFederation returns a single binding by ID, or ErrNotFound. There is no
 // get-by-id endpoint, so it lists the cluster's bindings and filters (as GetAPIKey does).
-func (c *Client) GetWorkloadIdentityFederation(virtualClusterID, id string) (*WorkloadIdentityFederation, error) {
-	feds, err := c.ListWorkloadIdentityFederations(virtualClusterID)
+func (c *Client) GetWorkloadIdentityFederation(ctx context.Context, virtualClusterID, id string) (*WorkloadIdentityFederation, error) {
+	feds, err := c.ListWorkloadIdentityFederations(ctx, virtualClusterID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list workload identity federations: %w", err)
 	}
@@ -122,13 +123,13 @@ func (c *Client) GetWorkloadIdentityFederation(virtualClusterID, id string) (*Wo
 }
 
 // DeleteWorkloadIdentityFederation deletes a binding by ID within its virtual cluster.
-func (c *Client) DeleteWorkloadIdentityFederation(virtualClusterID, id string) error {
+func (c *Client) DeleteWorkloadIdentityFederation(ctx context.Context, virtualClusterID, id string) error {
 	payload, err := json.Marshal(deleteWorkloadIdentityFederationRequest{VirtualClusterID: virtualClusterID, ID: id})
 	if err != nil {
 		return err
 	}
 
-	req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("%s/delete_workload_identity_federation", c.HostURL), bytes.NewReader(payload))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/delete_workload_identity_federation", c.HostURL), bytes.NewReader(payload))
 	if err != nil {
 		return err
 	}
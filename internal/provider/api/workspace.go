@@ -2,10 +2,12 @@ package api
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
+	"time"
 )
 
 type Workspace struct {
@@ -33,13 +35,34 @@ type WorkspaceRenameRequest struct {
 }
 
 // CreateWorkspace - Create new Workspace.
-func (c *Client) CreateWorkspace(name string) (string, error) {
+func (c *Client) CreateWorkspace(ctx context.Context, name string) (string, error) {
+	start := time.Now()
+	return createIdempotently(ctx,
+		func(ctx context.Context) (string, error) {
+			return c.createWorkspace(ctx, name)
+		},
+		func(ctx context.Context) (string, bool, error) {
+			workspaces, err := c.GetWorkspaces(ctx)
+			if err != nil {
+				return "", false, err
+			}
+			for _, ws := range workspaces {
+				if ws.Name == name && createdSince(ws.CreatedAt, start) {
+					return ws.ID, true, nil
+				}
+			}
+			return "", false, nil
+		},
+	)
+}
+
+func (c *Client) createWorkspace(ctx context.Context, name string) (string, error) {
 	payload, err := json.Marshal(WorkspaceCreateRequest{Name: name, SkipApplicationKeyCreation: true})
 	if err != nil {
 		return "", err
 	}
 
-	req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("%s/create_workspace", c.HostURL), bytes.NewReader(payload))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/create_workspace", c.HostURL), bytes.NewReader(payload))
 	if err != nil {
 		return "", err
 	}
@@ -61,13 +84,13 @@ func (c *Client) CreateWorkspace(name string) (string, error) {
 }
 
 // DeleteWorkspace - Delete a Workspace.
-func (c *Client) DeleteWorkspace(id string) error {
+func (c *Client) DeleteWorkspace(ctx context.Context, id string) error {
 	payload, err := json.Marshal(WorkspaceDeleteRequest{ID: id})
 	if err != nil {
 		return err
 	}
 
-	req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("%s/delete_workspace", c.HostURL), bytes.NewReader(payload))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/delete_workspace", c.HostURL), bytes.NewReader(payload))
 	if err != nil {
 		return err
 	}
@@ -85,8 +108,8 @@ func (c *Client) DeleteWorkspace(id string) error {
 }
 
 // GetWorkspaces - Returns list of Workspaces.
-func (c *Client) GetWorkspaces() ([]Workspace, error) {
-	req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("%s/list_workspaces", c.HostURL), nil)
+func (c *Client) GetWorkspaces(ctx context.Context) ([]Workspace, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/list_workspaces", c.HostURL), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -106,8 +129,8 @@ func (c *Client) GetWorkspaces() ([]Workspace, error) {
 }
 
 // GetWorkspace - Return one Workspace.
-func (c *Client) GetWorkspace(workspaceID string) (*Workspace, error) {
-	workspaces, err := c.GetWorkspaces()
+func (c *Client) GetWorkspace(ctx context.Context, workspaceID string) (*Workspace, error) {
+	workspaces, err := c.GetWorkspaces(ctx)
 
 	if err != nil {
 		return nil, fmt.Errorf("failed to get workspaces list: %w", err)
@@ -123,13 +146,13 @@ func (c *Client) GetWorkspace(workspaceID string) (*Workspace, error) {
 }
 
 // RenameWorkspace - Rename a Workspace.
-func (c *Client) RenameWorkspace(workspaceID string, newName string) error {
+func (c *Client) RenameWorkspace(ctx context.Context, workspaceID string, newName string) error {
 	payload, err := json.Marshal(WorkspaceRenameRequest{ID: workspaceID, Name: newName})
 	if err != nil {
 		return err
 	}
 
-	req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("%s/rename_workspace", c.HostURL), bytes.NewReader(payload))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/rename_workspace", c.HostURL), bytes.NewReader(payload))
 	if err != nil {
 		return err
 	}
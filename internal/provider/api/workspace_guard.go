@@ -0,0 +1,115 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"time"
+)
+
+// GuardsWorkspaces reports whether the provider restricts the workspaces it may manage.
+func (c *Client) GuardsWorkspaces() bool {
+	return len(c.AllowedWorkspaceIDs) > 0 || len(c.ForbiddenWorkspaceIDs) > 0
+}
+
+// CheckWorkspaceID returns an error when the provider's allowed_workspace_ids or
+// forbidden_workspace_ids rule out workspaceID.
+func (c *Client) CheckWorkspaceID(workspaceID string) error {
+	if slices.Contains(c.ForbiddenWorkspaceIDs, workspaceID) {
+		return fmt.Errorf("workspace %s is listed in forbidden_workspace_ids", workspaceID)
+	}
+	if len(c.AllowedWorkspaceIDs) > 0 && !slices.Contains(c.AllowedWorkspaceIDs, workspaceID) {
+		return fmt.Errorf("workspace %s is not listed in allowed_workspace_ids", workspaceID)
+	}
+	return nil
+}
+
+// ReachableWorkspaceIDs returns the IDs of the workspaces the API key can manage. Keys that may not
+// list workspaces, such as application keys, are scoped to one workspace, which is found through
+// the key's virtual clusters instead. The result is empty if such a key has no virtual clusters.
+//
+// Once the key has been identified, the answer comes from c.Identity without calling the API.
+func (c *Client) ReachableWorkspaceIDs(ctx context.Context) ([]string, error) {
+	switch c.Identity.Kind {
+	case KeyKindAccount:
+		return c.Identity.WorkspaceIDs, nil
+	case KeyKindApplication:
+		if c.Identity.WorkspaceID == "" {
+			return nil, nil
+		}
+		return []string{c.Identity.WorkspaceID}, nil
+	case KeyKindAgent:
+		return nil, nil
+	}
+
+	workspaces, err := c.GetWorkspaces(ctx)
+	if err == nil {
+		ids := make([]string, 0, len(workspaces))
+		for _, ws := range workspaces {
+			ids = append(ids, ws.ID)
+		}
+		return ids, nil
+	}
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || (apiErr.StatusCode != http.StatusUnauthorized && apiErr.StatusCode != http.StatusForbidden) {
+		return nil, err
+	}
+
+	clusters, err := c.GetVirtualClusters(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var ids []string
+	for _, vc := range clusters {
+		if vc.WorkspaceID != "" && !slices.Contains(ids, vc.WorkspaceID) {
+			ids = append(ids, vc.WorkspaceID)
+		}
+	}
+	return ids, nil
+}
+
+// DefaultWorkspaceID returns the workspace that requests without a workspace ID apply to: the
+// provider profile's workspace if it has one, and otherwise the oldest workspace the API key can
+// manage. The result is empty if that can't be told.
+func (c *Client) DefaultWorkspaceID(ctx context.Context) (string, error) {
+	if c.WorkspaceID != "" {
+		return c.WorkspaceID, nil
+	}
+
+	// Keys other than account keys can't list workspaces, and reach at most one.
+	if c.Identity.Kind == KeyKindUnknown || c.Identity.Kind == KeyKindAccount {
+		workspaces, err := c.GetWorkspaces(ctx)
+		if err == nil {
+			return oldestWorkspaceID(workspaces), nil
+		}
+		if !IsStatus(err, http.StatusUnauthorized) && !IsStatus(err, http.StatusForbidden) {
+			return "", err
+		}
+	}
+
+	ids, err := c.ReachableWorkspaceIDs(ctx)
+	if err != nil || len(ids) == 0 {
+		return "", err
+	}
+	return ids[0], nil
+}
+
+func oldestWorkspaceID(workspaces []Workspace) string {
+	var (
+		oldestID string
+		oldestAt time.Time
+	)
+	for _, ws := range workspaces {
+		createdAt, err := time.Parse(time.RFC3339Nano, ws.CreatedAt)
+		if err != nil {
+			continue
+		}
+		if oldestID == "" || createdAt.Before(oldestAt) {
+			oldestID, oldestAt = ws.ID, createdAt
+		}
+	}
+	return oldestID
+}
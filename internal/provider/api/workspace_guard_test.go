@@ -0,0 +1,150 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestCheckWorkspaceID(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		allowed   []string
+		forbidden []string
+		wantErr   string
+	}{
+		{name: "no restrictions"},
+		{name: "allowed", allowed: []string{"wi_prod", "wi_staging"}},
+		{name: "not allowed", allowed: []string{"wi_prod"}, wantErr: "not listed in allowed_workspace_ids"},
+		{name: "forbidden", forbidden: []string{"wi_staging"}, wantErr: "listed in forbidden_workspace_ids"},
+		{name: "not forbidden", forbidden: []string{"wi_prod"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			client := &Client{AllowedWorkspaceIDs: tt.allowed, ForbiddenWorkspaceIDs: tt.forbidden}
+			err := client.CheckWorkspaceID("wi_staging")
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("CheckWorkspaceID returned error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("expected an error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestReachableWorkspaceIDsFallsBackToVirtualClusters(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/list_workspaces"):
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"code":"forbidden","message":"application keys cannot list workspaces"}`))
+		case strings.HasSuffix(r.URL.Path, "/list_virtual_clusters"):
+			_, _ = w.Write([]byte(`{"virtual_clusters":[
+				{"id":"vci_a","workspace_id":"wi_app"},
+				{"id":"vci_b","workspace_id":"wi_app"}
+			]}`))
+		default:
+			t.Errorf("unexpected request to %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	opts := DefaultClientOptions()
+	opts.MaxRetries = 0
+	client := newTestClientWithOptions(t, server.URL, opts)
+
+	ids, err := client.ReachableWorkspaceIDs(t.Context())
+	if err != nil {
+		t.Fatalf("ReachableWorkspaceIDs returned error: %v", err)
+	}
+	if !slices.Equal(ids, []string{"wi_app"}) {
+		t.Fatalf("expected [wi_app], got %v", ids)
+	}
+}
+
+func TestDefaultWorkspaceID(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		profile string
+		account bool
+		want    string
+	}{
+		{name: "profile workspace", profile: "wi_profile", account: true, want: "wi_profile"},
+		{name: "oldest workspace of an account key", account: true, want: "wi_old"},
+		{name: "workspace of an application key", want: "wi_app"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch {
+				case strings.HasSuffix(r.URL.Path, "/list_workspaces") && tt.account:
+					_, _ = w.Write([]byte(`{"workspaces":[
+						{"id":"wi_new","created_at":"2024-05-01T00:00:00Z"},
+						{"id":"wi_old","created_at":"2023-01-01T00:00:00Z"}
+					]}`))
+				case strings.HasSuffix(r.URL.Path, "/list_workspaces"):
+					w.WriteHeader(http.StatusForbidden)
+					_, _ = w.Write([]byte(`{"code":"forbidden","message":"application keys cannot list workspaces"}`))
+				case strings.HasSuffix(r.URL.Path, "/list_virtual_clusters"):
+					_, _ = w.Write([]byte(`{"virtual_clusters":[{"id":"vci_a","workspace_id":"wi_app"}]}`))
+				default:
+					t.Errorf("unexpected request to %s", r.URL.Path)
+				}
+			}))
+			defer server.Close()
+
+			opts := DefaultClientOptions()
+			opts.MaxRetries = 0
+			client := newTestClientWithOptions(t, server.URL, opts)
+			client.WorkspaceID = tt.profile
+
+			got, err := client.DefaultWorkspaceID(t.Context())
+			if err != nil {
+				t.Fatalf("DefaultWorkspaceID returned error: %v", err)
+			}
+			if got != tt.want {
+				t.Fatalf("expected %s, got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestReachableWorkspaceIDsUsesIdentity(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request to %s", r.URL.Path)
+	}))
+	defer server.Close()
+
+	opts := DefaultClientOptions()
+	opts.MaxRetries = 0
+	client := newTestClientWithOptions(t, server.URL, opts)
+	client.Identity = KeyIdentity{Kind: KeyKindApplication, WorkspaceID: "wi_app"}
+
+	ids, err := client.ReachableWorkspaceIDs(t.Context())
+	if err != nil {
+		t.Fatalf("ReachableWorkspaceIDs returned error: %v", err)
+	}
+	if !slices.Equal(ids, []string{"wi_app"}) {
+		t.Fatalf("expected [wi_app], got %v", ids)
+	}
+}
@@ -0,0 +1,234 @@
+// Package cassette records the HTTP traffic of the provider's API client to disk and replays it,
+// so that acceptance tests can run without credentials or network access. A Cassette is plugged in
+// through ClientOptions.WrapTransport, beneath retries and rate limiting.
+package cassette
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/warpstreamlabs/terraform-provider-warpstream/internal/provider/api"
+)
+
+// Mode is whether a Cassette captures live traffic or serves captured traffic back.
+type Mode int
+
+const (
+	// Record passes requests through to the API and captures each exchange.
+	Record Mode = iota
+	// Replay answers requests from the captured exchanges without touching the network.
+	Replay
+)
+
+// missStatus is returned for a replayed request that matches nothing on the cassette.
+// retryablehttp does not retry 501s, so a miss fails the call straight away.
+const missStatus = http.StatusNotImplemented
+
+// volatileHeaders change on every run, so they are left off cassettes to keep re-recordings
+// diffable.
+var volatileHeaders = map[string]struct{}{
+	http.CanonicalHeaderKey(api.IdempotencyKeyHeader): {},
+	http.CanonicalHeaderKey("Content-Length"):         {},
+	http.CanonicalHeaderKey("Date"):                   {},
+}
+
+// Cassette records the HTTP exchanges an api.Client makes, or replays recorded ones, at the transport
+// beneath retries and rate limiting. Secrets are redacted before anything is written to a cassette,
+// the same way they are in debug logs, so replayed responses carry masked secrets too.
+//
+// Replay matches a request by method, path and redacted body. Each recorded exchange is served
+// once, in recorded order; once all matching exchanges have been served, the last one is served
+// again, since reads are repeated more or less often depending on caching and timing.
+type Cassette struct {
+	mode Mode
+
+	mu           sync.Mutex
+	interactions []Interaction
+	served       []bool
+}
+
+// Interaction is one recorded request and the response the API gave it.
+type Interaction struct {
+	Request  Request  `json:"request"`
+	Response Response `json:"response"`
+}
+
+type Request struct {
+	Method  string            `json:"method"`
+	Path    string            `json:"path"`
+	Headers map[string]string `json:"headers,omitempty"`
+	Body    string            `json:"body,omitempty"`
+}
+
+type Response struct {
+	StatusCode int               `json:"status_code"`
+	Headers    map[string]string `json:"headers,omitempty"`
+	Body       string            `json:"body,omitempty"`
+}
+
+type cassetteFile struct {
+	Interactions []Interaction `json:"interactions"`
+}
+
+// NewRecorder returns an empty cassette in record mode.
+func NewRecorder() *Cassette {
+	return &Cassette{mode: Record}
+}
+
+// Load reads a recorded cassette for replay.
+func Load(path string) (*Cassette, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var file cassetteFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("parsing cassette %s: %w", path, err)
+	}
+	return &Cassette{
+		mode:         Replay,
+		interactions: file.Interactions,
+		served:       make([]bool, len(file.Interactions)),
+	}, nil
+}
+
+// Save writes the recorded exchanges to path, creating its directory if needed.
+func (c *Cassette) Save(path string) error {
+	c.mu.Lock()
+	data, err := json.MarshalIndent(cassetteFile{Interactions: c.interactions}, "", "  ")
+	c.mu.Unlock()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(data, '\n'), 0o644)
+}
+
+// Interactions returns the exchanges on the cassette.
+func (c *Cassette) Interactions() []Interaction {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]Interaction(nil), c.interactions...)
+}
+
+// WrapTransport puts the cassette in front of next. In replay mode next is never called.
+func (c *Cassette) WrapTransport(next http.RoundTripper) http.RoundTripper {
+	return &transport{cassette: c, next: next}
+}
+
+type transport struct {
+	cassette *Cassette
+	next     http.RoundTripper
+}
+
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	var reqBody []byte
+	if req.Body != nil {
+		var err error
+		reqBody, err = io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		req.Body = io.NopCloser(bytes.NewReader(reqBody))
+	}
+	recorded := Request{
+		Method:  req.Method,
+		Path:    req.URL.Path,
+		Headers: recordedHeaders(req.Header),
+		Body:    api.RedactBody(reqBody),
+	}
+
+	if t.cassette.mode == Replay {
+		return t.cassette.replay(req, recorded), nil
+	}
+
+	res, err := t.next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	resBody, err := io.ReadAll(res.Body)
+	res.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	res.Body = io.NopCloser(bytes.NewReader(resBody))
+
+	t.cassette.mu.Lock()
+	t.cassette.interactions = append(t.cassette.interactions, Interaction{
+		Request: recorded,
+		Response: Response{
+			StatusCode: res.StatusCode,
+			Headers:    recordedHeaders(res.Header),
+			Body:       api.RedactBody(resBody),
+		},
+	})
+	t.cassette.mu.Unlock()
+	return res, nil
+}
+
+func (c *Cassette) replay(req *http.Request, recorded Request) *http.Response {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	match := -1
+	for i, in := range c.interactions {
+		if in.Request.Method != recorded.Method || in.Request.Path != recorded.Path || in.Request.Body != recorded.Body {
+			continue
+		}
+		match = i
+		if !c.served[i] {
+			break
+		}
+	}
+	if match < 0 {
+		body, _ := json.Marshal(map[string]string{
+			"code":    "cassette_miss",
+			"message": fmt.Sprintf("no recorded interaction matches %s %s %s", recorded.Method, recorded.Path, recorded.Body),
+		})
+		return replayedResponse(req, Response{StatusCode: missStatus, Body: string(body)})
+	}
+
+	c.served[match] = true
+	return replayedResponse(req, c.interactions[match].Response)
+}
+
+func replayedResponse(req *http.Request, recorded Response) *http.Response {
+	header := make(http.Header, len(recorded.Headers))
+	for k, v := range recorded.Headers {
+		header.Set(k, v)
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", recorded.StatusCode, http.StatusText(recorded.StatusCode)),
+		StatusCode:    recorded.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(strings.NewReader(recorded.Body)),
+		ContentLength: int64(len(recorded.Body)),
+		Request:       req,
+	}
+}
+
+func recordedHeaders(h http.Header) map[string]string {
+	out := api.RedactHeaders(h)
+	for k := range out {
+		if _, ok := volatileHeaders[http.CanonicalHeaderKey(k)]; ok {
+			delete(out, k)
+		}
+	}
+	if len(out) == 0 {
+		return nil
+	}
+	return out
+}
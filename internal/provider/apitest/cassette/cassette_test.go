@@ -0,0 +1,108 @@
+package cassette
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/warpstreamlabs/terraform-provider-warpstream/internal/provider/api"
+)
+
+func newTestClient(t *testing.T, host string, opts api.ClientOptions) *api.Client {
+	t.Helper()
+
+	token := "test-token"
+	client, err := api.NewClient(host, &token, "test", opts)
+	if err != nil {
+		t.Fatalf("NewClient returned error: %v", err)
+	}
+	return client
+}
+
+func TestCassetteRecordsAndReplays(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := calls.Add(1)
+		_, _ = fmt.Fprintf(w, `{"workspaces":[{"id":"wi_%d","name":"aks_secret%d"}]}`, n, n)
+	}))
+	defer server.Close()
+
+	recorder := NewRecorder()
+	opts := api.DefaultClientOptions()
+	opts.MaxRetries = 0
+	opts.WrapTransport = recorder.WrapTransport
+	client := newTestClient(t, server.URL, opts)
+	for range 2 {
+		if _, err := client.GetWorkspaces(t.Context()); err != nil {
+			t.Fatalf("GetWorkspaces returned error: %v", err)
+		}
+	}
+
+	path := filepath.Join(t.TempDir(), "cassettes", "workspaces.json")
+	if err := recorder.Save(path); err != nil {
+		t.Fatalf("Save returned error: %v", err)
+	}
+	saved, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("reading cassette: %v", err)
+	}
+	for _, secret := range []string{"test-token", "aks_secret1", "aks_secret2"} {
+		if strings.Contains(string(saved), secret) {
+			t.Fatalf("cassette contains secret %q:\n%s", secret, saved)
+		}
+	}
+	server.Close()
+
+	cassette, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load returned error: %v", err)
+	}
+	opts.WrapTransport = cassette.WrapTransport
+	client = newTestClient(t, server.URL, opts)
+
+	// Recorded responses come back in order, and the last one repeats once they run out.
+	for _, want := range []string{"wi_1", "wi_2", "wi_2"} {
+		workspaces, err := client.GetWorkspaces(t.Context())
+		if err != nil {
+			t.Fatalf("replayed GetWorkspaces returned error: %v", err)
+		}
+		if len(workspaces) != 1 || workspaces[0].ID != want {
+			t.Fatalf("expected workspace %s, got %+v", want, workspaces)
+		}
+		if workspaces[0].Name != "aks_***REDACTED***" {
+			t.Fatalf("expected the replayed secret to be redacted, got %q", workspaces[0].Name)
+		}
+	}
+}
+
+func TestCassetteReplayMiss(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "empty.json")
+	if err := NewRecorder().Save(path); err != nil {
+		t.Fatalf("Save returned error: %v", err)
+	}
+	cassette, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load returned error: %v", err)
+	}
+
+	opts := api.DefaultClientOptions()
+	opts.WrapTransport = cassette.WrapTransport
+	client := newTestClient(t, "http://cassette.invalid/api/v1", opts)
+
+	_, err = client.GetWorkspaces(t.Context())
+	if !api.IsStatus(err, http.StatusNotImplemented) {
+		t.Fatalf("expected a 501 for an unrecorded request, got %v", err)
+	}
+	if !strings.Contains(err.Error(), "no recorded interaction matches POST /api/v1/list_workspaces") {
+		t.Fatalf("expected the miss to name the request, got %v", err)
+	}
+}
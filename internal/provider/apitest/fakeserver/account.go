@@ -0,0 +1,307 @@
+package fakeserver
+
+import (
+	"strings"
+
+	"github.com/warpstreamlabs/terraform-provider-warpstream/internal/provider/api"
+)
+
+func (s *Server) addWorkspace(name string) *api.Workspace {
+	ws := &api.Workspace{
+		ID:        s.newID("wi_"),
+		Name:      name,
+		CreatedAt: s.timestamp(),
+	}
+	s.workspaces[ws.ID] = ws
+	return ws
+}
+
+func (s *Server) createWorkspace(body []byte) (any, error) {
+	req, err := decode[api.WorkspaceCreateRequest](body)
+	if err != nil {
+		return nil, err
+	}
+	if req.Name == "" {
+		return nil, badRequest("workspace name is required")
+	}
+
+	ws := s.addWorkspace(req.Name)
+	return map[string]string{"workspace_id": ws.ID}, nil
+}
+
+func (s *Server) deleteWorkspace(body []byte) (any, error) {
+	req, err := decode[api.WorkspaceDeleteRequest](body)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := s.workspaces[req.ID]; !ok {
+		return nil, notFound("workspace not found.")
+	}
+	for _, vc := range s.clusters {
+		if vc.WorkspaceID == req.ID {
+			return nil, badRequest("workspace %s still has virtual clusters", req.ID)
+		}
+	}
+
+	delete(s.workspaces, req.ID)
+	return empty, nil
+}
+
+func (s *Server) listWorkspaces([]byte) (any, error) {
+	workspaces := make([]api.Workspace, 0, len(s.workspaces))
+	for _, ws := range sortedValues(s.workspaces) {
+		workspaces = append(workspaces, *ws)
+	}
+	return api.WorkspaceListResponse{Workspaces: workspaces}, nil
+}
+
+func (s *Server) renameWorkspace(body []byte) (any, error) {
+	req, err := decode[api.WorkspaceRenameRequest](body)
+	if err != nil {
+		return nil, err
+	}
+	ws, ok := s.workspaces[req.ID]
+	if !ok {
+		return nil, notFound("workspace not found.")
+	}
+
+	ws.Name = req.Name
+	return empty, nil
+}
+
+func (s *Server) createAPIKey(body []byte) (any, error) {
+	req, err := decode[api.APIKeyCreateRequest](body)
+	if err != nil {
+		return nil, err
+	}
+	if len(req.AccessGrants) != 1 {
+		return nil, badRequest("exactly one access grant is required")
+	}
+
+	name := "akn_" + req.Name
+	for _, key := range s.apiKeys {
+		if key.Name == name {
+			return nil, conflict("duplicate_api_key_name", "an API key named %s already exists", name)
+		}
+	}
+
+	grant := api.AccessGrant{
+		PrincipalKind: req.AccessGrants[0]["principal_kind"],
+		ResourceKind:  req.AccessGrants[0]["resource_kind"],
+		ResourceID:    req.AccessGrants[0]["resource_id"],
+		WorkspaceID:   req.AccessGrants[0]["workspace_id"],
+	}
+	switch grant.PrincipalKind {
+	case api.PrincipalKindAgent, api.PrincipalKindAgentReadOnly:
+		vc, ok := s.clusters[grant.ResourceID]
+		if !ok {
+			return nil, notFound("virtual cluster not found.")
+		}
+		grant.WorkspaceID = vc.WorkspaceID
+	case api.PrincipalKindApplication, api.PrincipalKindApplicationReadOnly:
+		if grant.WorkspaceID == "" {
+			grant.WorkspaceID = s.defaultWorkspaceID
+		}
+		if _, ok := s.workspaces[grant.WorkspaceID]; !ok {
+			return nil, notFound("workspace not found.")
+		}
+	default:
+		return nil, badRequest("unsupported principal kind %q", grant.PrincipalKind)
+	}
+
+	return s.addAPIKey(name, grant), nil
+}
+
+func (s *Server) addAPIKey(name string, grant api.AccessGrant) *api.APIKey {
+	key := &api.APIKey{
+		ID:           s.newID("aki_"),
+		Name:         name,
+		Key:          newSecret("aks_"),
+		AccessGrants: api.AccessGrants{grant},
+		CreatedAt:    s.timestamp(),
+	}
+	s.apiKeys[key.ID] = key
+	return key
+}
+
+func (s *Server) deleteAPIKey(body []byte) (any, error) {
+	req, err := decode[api.APIKeyDeleteRequest](body)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := s.apiKeys[req.ID]; !ok {
+		return nil, notFound("api key not found.")
+	}
+
+	delete(s.apiKeys, req.ID)
+	return empty, nil
+}
+
+func (s *Server) listAPIKeys([]byte) (any, error) {
+	keys := make([]api.APIKey, 0, len(s.apiKeys))
+	for _, key := range sortedValues(s.apiKeys) {
+		// The secret is only ever returned on creation.
+		listed := *key
+		listed.Key = ""
+		keys = append(keys, listed)
+	}
+	return api.APIKeyListResponse{APIKeys: keys}, nil
+}
+
+// agentKeys returns the agent keys scoped to a virtual cluster, as listed in its describe.
+func (s *Server) agentKeys(vcID string) []api.APIKey {
+	keys := []api.APIKey{}
+	for _, key := range sortedValues(s.apiKeys) {
+		grant := key.AccessGrants[0]
+		isAgent := grant.PrincipalKind == api.PrincipalKindAgent || grant.PrincipalKind == api.PrincipalKindAgentReadOnly
+		if isAgent && grant.ResourceID == vcID {
+			listed := *key
+			listed.Key = ""
+			keys = append(keys, listed)
+		}
+	}
+	return keys
+}
+
+func (s *Server) createUserRole(body []byte) (any, error) {
+	req, err := decode[api.UserRoleCreateRequest](body)
+	if err != nil {
+		return nil, err
+	}
+	if req.Name == "" {
+		return nil, badRequest("user role name is required")
+	}
+	for _, role := range s.userRoles {
+		if role.Name == req.Name {
+			return nil, conflict("duplicate_user_role_name", "a user role named %s already exists", req.Name)
+		}
+	}
+	if err := s.validateGrants(req.AccessGrants); err != nil {
+		return nil, err
+	}
+
+	role := &api.UserRole{
+		ID:           s.newID("uri_"),
+		Name:         req.Name,
+		AccessGrants: req.AccessGrants,
+		CreatedAt:    s.timestamp(),
+	}
+	s.userRoles[role.ID] = role
+	return map[string]string{"user_role_id": role.ID}, nil
+}
+
+func (s *Server) updateUserRole(body []byte) (any, error) {
+	req, err := decode[api.UserRoleUpdateRequest](body)
+	if err != nil {
+		return nil, err
+	}
+	role, ok := s.userRoles[req.ID]
+	if !ok {
+		return nil, notFound("user role not found.")
+	}
+	if err := s.validateGrants(req.AccessGrants); err != nil {
+		return nil, err
+	}
+
+	role.Name = req.Name
+	role.AccessGrants = req.AccessGrants
+	return empty, nil
+}
+
+// validateGrants checks that a role's grants point at workspaces that exist. The billing grant
+// uses the empty workspace ID "-".
+func (s *Server) validateGrants(grants []api.AccessGrant) error {
+	for _, grant := range grants {
+		if grant.WorkspaceID == "-" || grant.WorkspaceID == api.WorkspaceIDAny {
+			continue
+		}
+		if _, ok := s.workspaces[grant.WorkspaceID]; !ok {
+			return notFound("workspace not found.")
+		}
+	}
+	return nil
+}
+
+func (s *Server) deleteUserRole(body []byte) (any, error) {
+	req, err := decode[api.UserRoleDeleteRequest](body)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := s.userRoles[req.ID]; !ok {
+		return nil, notFound("user role not found.")
+	}
+
+	delete(s.userRoles, req.ID)
+	return empty, nil
+}
+
+func (s *Server) listUserRoles([]byte) (any, error) {
+	roles := make([]api.UserRole, 0, len(s.userRoles))
+	for _, role := range sortedValues(s.userRoles) {
+		roles = append(roles, *role)
+	}
+	return api.UserRoleListResponse{Roles: roles}, nil
+}
+
+func (s *Server) createSSOConfiguration(body []byte) (any, error) {
+	req, err := decode[api.SSOConfigurationCreateRequest](body)
+	if err != nil {
+		return nil, err
+	}
+	if s.sso != nil {
+		return nil, conflict("sso_configuration_exists", "an SSO configuration already exists")
+	}
+	if !strings.Contains(req.SigningCertificate, "BEGIN CERTIFICATE") {
+		return nil, badRequest("signing_certificate must be a PEM encoded certificate")
+	}
+
+	s.sso = &api.SSOConfiguration{
+		ID:                   s.newID("sso_"),
+		SSOIdentifier:        req.SSOIdentifier,
+		EntityID:             req.EntityID,
+		SAMLURL:              req.SAMLURL,
+		DefaultRoleID:        req.DefaultRoleID,
+		EnableSSORoleMapping: req.EnableSSORoleMapping,
+		SigningCertificate:   req.SigningCertificate,
+	}
+	return map[string]string{"sso_connection_id": s.sso.ID}, nil
+}
+
+func (s *Server) updateSSOConfiguration(body []byte) (any, error) {
+	req, err := decode[api.SSOConfigurationUpdateRequest](body)
+	if err != nil {
+		return nil, err
+	}
+	if s.sso == nil || s.sso.ID != req.SSOConnectionID {
+		return nil, notFound("sso configuration not found.")
+	}
+
+	s.sso.EntityID = req.EntityID
+	s.sso.SAMLURL = req.SAMLURL
+	s.sso.DefaultRoleID = req.DefaultRoleID
+	s.sso.EnableSSORoleMapping = req.EnableSSORoleMapping
+	s.sso.SigningCertificate = req.SigningCertificate
+	return empty, nil
+}
+
+func (s *Server) deleteSSOConfiguration(body []byte) (any, error) {
+	req, err := decode[api.SSOConfigurationDeleteRequest](body)
+	if err != nil {
+		return nil, err
+	}
+	if s.sso == nil || s.sso.ID != req.SSOConnectionID {
+		return nil, notFound("sso configuration not found.")
+	}
+
+	s.sso = nil
+	return empty, nil
+}
+
+func (s *Server) getSSOConfiguration([]byte) (any, error) {
+	if s.sso == nil {
+		return nil, notFound("sso configuration not found.")
+	}
+
+	sso := *s.sso
+	return api.SSOConfigurationGetResponseRequest{SSOConfiguration: &sso}, nil
+}
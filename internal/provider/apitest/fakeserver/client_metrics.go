@@ -0,0 +1,88 @@
+package fakeserver
+
+import (
+	"github.com/warpstreamlabs/terraform-provider-warpstream/internal/provider/api"
+)
+
+func (s *Server) listClientMetricsSubscriptions(body []byte) (any, error) {
+	req, err := decode[api.ClientMetricsSubscriptionListRequest](body)
+	if err != nil {
+		return nil, err
+	}
+	vc, err := s.cluster(req.VirtualClusterID)
+	if err != nil {
+		return nil, err
+	}
+
+	return api.ClientMetricsSubscriptionListResponse{Subscriptions: sortedValues(vc.subscriptions)}, nil
+}
+
+func (s *Server) describeClientMetricsSubscription(body []byte) (any, error) {
+	req, err := decode[api.ClientMetricsSubscriptionDescribeRequest](body)
+	if err != nil {
+		return nil, err
+	}
+	vc, err := s.cluster(req.VirtualClusterID)
+	if err != nil {
+		return nil, err
+	}
+	sub, ok := vc.subscriptions[req.Name]
+	if !ok {
+		return nil, notFound("client metrics subscription %s not found.", req.Name)
+	}
+
+	return api.ClientMetricsSubscriptionDescribeResponse{Subscription: sub}, nil
+}
+
+// updateClientMetricsSubscriptions upserts the whole batch, or nothing if any entry is invalid.
+func (s *Server) updateClientMetricsSubscriptions(body []byte) (any, error) {
+	req, err := decode[api.ClientMetricsSubscriptionsUpdateRequest](body)
+	if err != nil {
+		return nil, err
+	}
+	vc, err := s.cluster(req.VirtualClusterID)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := map[string]struct{}{}
+	for _, sub := range req.ClientMetricsSubscriptions {
+		if sub.Name == "" {
+			return nil, badRequest("client metrics subscription name is required")
+		}
+		if _, dup := seen[sub.Name]; dup {
+			return nil, badRequest("duplicate client metrics subscription name %q", sub.Name)
+		}
+		if sub.IntervalMs != nil && *sub.IntervalMs <= 0 {
+			return nil, badRequest("client metrics subscription %q: interval_ms must be positive", sub.Name)
+		}
+		seen[sub.Name] = struct{}{}
+	}
+
+	for _, sub := range req.ClientMetricsSubscriptions {
+		vc.subscriptions[sub.Name] = sub
+	}
+	return empty, nil
+}
+
+// deleteClientMetricsSubscriptions deletes the whole batch, or nothing if any name is missing.
+func (s *Server) deleteClientMetricsSubscriptions(body []byte) (any, error) {
+	req, err := decode[api.ClientMetricsSubscriptionsDeleteRequest](body)
+	if err != nil {
+		return nil, err
+	}
+	vc, err := s.cluster(req.VirtualClusterID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, name := range req.ClientMetricsSubscriptionNames {
+		if _, ok := vc.subscriptions[name]; !ok {
+			return nil, notFound("client metrics subscription %s not found.", name)
+		}
+	}
+	for _, name := range req.ClientMetricsSubscriptionNames {
+		delete(vc.subscriptions, name)
+	}
+	return empty, nil
+}
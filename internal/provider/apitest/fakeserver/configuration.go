@@ -0,0 +1,170 @@
+package fakeserver
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/warpstreamlabs/terraform-provider-warpstream/internal/provider/api"
+)
+
+// brokerConfig describes a cluster-level broker config the server accepts.
+type brokerConfig struct {
+	// normalize parses a value and returns it the way describe reports it, e.g. "TRUE" as "true".
+	normalize func(string) (string, error)
+	// apply copies a normalized value onto the typed configuration fields, for the configs that
+	// have one.
+	apply func(cfg *api.VirtualClusterConfiguration, value string)
+}
+
+// brokerConfigAlias is a config the API accepts on write as an alternate unit of another, but
+// never reports back on describe.
+type brokerConfigAlias struct {
+	canonical  string
+	multiplier int64
+}
+
+var brokerConfigs = map[string]brokerConfig{
+	"auto.create.topics.enable": {normalizeBool, func(cfg *api.VirtualClusterConfiguration, v string) {
+		cfg.AutoCreateTopic = v == "true"
+	}},
+	"num.partitions": {normalizeInt, func(cfg *api.VirtualClusterConfiguration, v string) {
+		cfg.DefaultNumPartitions, _ = strconv.ParseInt(v, 10, 64)
+	}},
+	"log.retention.ms": {normalizeInt, func(cfg *api.VirtualClusterConfiguration, v string) {
+		cfg.DefaultRetentionMillis, _ = strconv.ParseInt(v, 10, 64)
+	}},
+	"warpstream.default.topic.type": {normalizeOneOf("classic", "lightning"), func(cfg *api.VirtualClusterConfiguration, v string) {
+		cfg.DefaultTopicType = v
+	}},
+	"warpstream.soft.delete.topic.enable": {normalizeBool, func(cfg *api.VirtualClusterConfiguration, v string) {
+		cfg.EnableSoftTopicDeletion = v == "true"
+	}},
+	"warpstream.soft.delete.topic.ttl.ms": {normalizeInt, func(cfg *api.VirtualClusterConfiguration, v string) {
+		ms, _ := strconv.ParseInt(v, 10, 64)
+		ttl := time.Duration(ms) * time.Millisecond
+		cfg.SoftTopicDeletionTTL = &ttl
+	}},
+	"delete.topic.enable":       {normalize: normalizeBool},
+	"message.max.bytes":         {normalize: normalizeInt},
+	"offsets.retention.minutes": {normalize: normalizeInt},
+}
+
+var brokerConfigAliases = map[string]brokerConfigAlias{
+	"log.retention.minutes":                  {"log.retention.ms", int64(time.Minute / time.Millisecond)},
+	"log.retention.hours":                    {"log.retention.ms", int64(time.Hour / time.Millisecond)},
+	"warpstream.soft.delete.topic.ttl.hours": {"warpstream.soft.delete.topic.ttl.ms", int64(time.Hour / time.Millisecond)},
+}
+
+func normalizeBool(v string) (string, error) {
+	b, err := strconv.ParseBool(v)
+	if err != nil {
+		return "", err
+	}
+	return strconv.FormatBool(b), nil
+}
+
+func normalizeInt(v string) (string, error) {
+	n, err := strconv.ParseInt(v, 10, 64)
+	if err != nil {
+		return "", err
+	}
+	return strconv.FormatInt(n, 10), nil
+}
+
+func normalizeOneOf(allowed ...string) func(string) (string, error) {
+	return func(v string) (string, error) {
+		if !slices.Contains(allowed, v) {
+			return "", fmt.Errorf("must be one of %v", allowed)
+		}
+		return v, nil
+	}
+}
+
+// normalizeBrokerConfigs validates a broker_configs update and returns it keyed by canonical
+// name, with each value as describe will report it. Nothing is applied unless every entry is valid.
+func normalizeBrokerConfigs(update map[string]string) (map[string]string, error) {
+	out := make(map[string]string, len(update))
+	for _, key := range slices.Sorted(maps.Keys(update)) {
+		value := update[key]
+		canonical, multiplier := key, int64(1)
+		if alias, ok := brokerConfigAliases[key]; ok {
+			canonical, multiplier = alias.canonical, alias.multiplier
+		}
+
+		config, ok := brokerConfigs[canonical]
+		if !ok {
+			return nil, badRequest("unsupported cluster config %q", key)
+		}
+		normalized, err := config.normalize(value)
+		if err != nil {
+			return nil, badRequest("invalid cluster config %q: %v", key, err)
+		}
+		if multiplier != 1 {
+			n, _ := strconv.ParseInt(normalized, 10, 64)
+			normalized = strconv.FormatInt(n*multiplier, 10)
+		}
+		out[canonical] = normalized
+	}
+	return out, nil
+}
+
+func (s *Server) describeConfiguration(body []byte) (any, error) {
+	req, err := decode[api.ConfigurationDescribeRequest](body)
+	if err != nil {
+		return nil, err
+	}
+	vc, err := s.cluster(req.VirtualClusterID)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg := vc.config
+	cfg.Tier = vc.Tier
+	if len(vc.brokerConfigs) > 0 {
+		cfg.BrokerConfigs = make(map[string]*string, len(vc.brokerConfigs))
+		for k, v := range vc.brokerConfigs {
+			cfg.BrokerConfigs[k] = &v
+		}
+	}
+	return api.ConfigurationDescribeResponse{Configuration: cfg}, nil
+}
+
+func (s *Server) updateConfiguration(body []byte) (any, error) {
+	req, err := decode[api.ConfigurationUpdateRequest](body)
+	if err != nil {
+		return nil, err
+	}
+	vc, err := s.cluster(req.VirtualClusterID)
+	if err != nil {
+		return nil, err
+	}
+
+	update := req.Configuration
+	if update.AclsEnabled && update.ACLShadowingEnabled {
+		return nil, badRequest("acls and acl shadowing cannot both be enabled")
+	}
+	if update.Tier != "" && !slices.Contains(clusterTiers, update.Tier) {
+		return nil, badRequest("unsupported virtual cluster tier %q", update.Tier)
+	}
+	normalized, err := normalizeBrokerConfigs(update.BrokerConfigs)
+	if err != nil {
+		return nil, err
+	}
+
+	vc.config.AclsEnabled = update.AclsEnabled
+	vc.config.ACLShadowingEnabled = update.ACLShadowingEnabled
+	vc.config.EnableDeletionProtection = update.EnableDeletionProtection
+	if update.Tier != "" {
+		vc.Tier = update.Tier
+	}
+	for key, value := range normalized {
+		vc.brokerConfigs[key] = value
+		if apply := brokerConfigs[key].apply; apply != nil {
+			apply(&vc.config, value)
+		}
+	}
+	return empty, nil
+}
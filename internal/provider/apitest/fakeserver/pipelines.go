@@ -0,0 +1,160 @@
+package fakeserver
+
+import (
+	"slices"
+
+	"github.com/warpstreamlabs/terraform-provider-warpstream/internal/provider/api"
+)
+
+var pipelineStates = []string{"running", "paused"}
+
+type pipeline struct {
+	overview       api.HTTPPipelineOverview
+	configurations []api.HTTPPipelineConfiguration
+}
+
+func (s *Server) pipeline(vcID, id string) (*virtualCluster, *pipeline, error) {
+	vc, err := s.cluster(vcID)
+	if err != nil {
+		return nil, nil, err
+	}
+	p, ok := vc.pipelines[id]
+	if !ok {
+		return nil, nil, notFound("pipeline %s not found.", id)
+	}
+	return vc, p, nil
+}
+
+func (s *Server) createPipeline(body []byte) (any, error) {
+	req, err := decode[api.HTTPCreatePipelineRequest](body)
+	if err != nil {
+		return nil, err
+	}
+	vc, err := s.cluster(req.VirtualClusterID)
+	if err != nil {
+		return nil, err
+	}
+	if req.PipelineName == "" {
+		return nil, badRequest("pipeline name is required")
+	}
+	for _, p := range vc.pipelines {
+		if p.overview.Name == req.PipelineName {
+			return nil, conflict("duplicate_pipeline_name", "a pipeline named %s already exists", req.PipelineName)
+		}
+	}
+
+	p := &pipeline{overview: api.HTTPPipelineOverview{
+		ID:    s.newID("pi_"),
+		Name:  req.PipelineName,
+		State: "paused",
+		Type:  req.Type,
+	}}
+	vc.pipelines[p.overview.ID] = p
+
+	return api.HTTPCreatePipelineResponse{
+		PipelineID:    p.overview.ID,
+		PipelineName:  p.overview.Name,
+		PipelineState: p.overview.State,
+		PipelineType:  p.overview.Type,
+	}, nil
+}
+
+func (s *Server) listPipelines(body []byte) (any, error) {
+	req, err := decode[api.HTTPListPipelinesRequest](body)
+	if err != nil {
+		return nil, err
+	}
+	vc, err := s.cluster(req.VirtualClusterID)
+	if err != nil {
+		return nil, err
+	}
+
+	pipelines := make([]api.HTTPPipelineOverview, 0, len(vc.pipelines))
+	for _, p := range sortedValues(vc.pipelines) {
+		pipelines = append(pipelines, p.overview)
+	}
+	return api.HTTPListPipelinesResponse{Pipelines: pipelines}, nil
+}
+
+func (s *Server) describePipeline(body []byte) (any, error) {
+	req, err := decode[api.HTTPDescribePipelineRequest](body)
+	if err != nil {
+		return nil, err
+	}
+	_, p, err := s.pipeline(req.VirtualClusterID, req.PipelineID)
+	if err != nil {
+		return nil, err
+	}
+
+	return api.HTTPDescribePipelineResponse{
+		PipelineOverview: p.overview,
+		Configurations:   slices.Clone(p.configurations),
+	}, nil
+}
+
+func (s *Server) createPipelineConfiguration(body []byte) (any, error) {
+	req, err := decode[api.HTTPCreatePipelineConfigurationRequest](body)
+	if err != nil {
+		return nil, err
+	}
+	_, p, err := s.pipeline(req.VirtualClusterID, req.PipelineID)
+	if err != nil {
+		return nil, err
+	}
+	if req.ConfigurationYAML == "" && len(req.ConfigurationInputs) == 0 {
+		return nil, badRequest("either configuration_yaml or configuration_inputs is required")
+	}
+
+	cfg := api.HTTPPipelineConfiguration{
+		ID:                  s.newID("pc_"),
+		Version:             len(p.configurations) + 1,
+		ConfigurationYAML:   req.ConfigurationYAML,
+		ConfigurationInputs: req.ConfigurationInputs,
+	}
+	p.configurations = append(p.configurations, cfg)
+	return api.HTTPCreatePipelineConfigurationResponse{ConfigurationID: cfg.ID}, nil
+}
+
+func (s *Server) changePipelineState(body []byte) (any, error) {
+	req, err := decode[api.HTTPChangePipelineStateRequest](body)
+	if err != nil {
+		return nil, err
+	}
+	_, p, err := s.pipeline(req.VirtualClusterID, req.PipelineID)
+	if err != nil {
+		return nil, err
+	}
+	if req.DesiredState != nil && !slices.Contains(pipelineStates, *req.DesiredState) {
+		return nil, badRequest("invalid pipeline state %q", *req.DesiredState)
+	}
+	if req.DeployedConfigurationID != nil {
+		found := slices.ContainsFunc(p.configurations, func(cfg api.HTTPPipelineConfiguration) bool {
+			return cfg.ID == *req.DeployedConfigurationID
+		})
+		if !found {
+			return nil, notFound("pipeline configuration %s not found.", *req.DeployedConfigurationID)
+		}
+	}
+
+	if req.DesiredState != nil {
+		p.overview.State = *req.DesiredState
+	}
+	if req.DeployedConfigurationID != nil {
+		p.overview.DeployedConfigurationId = *req.DeployedConfigurationID
+	}
+	return api.HTTPChangePipelineStateResponse{}, nil
+}
+
+func (s *Server) deletePipeline(body []byte) (any, error) {
+	req, err := decode[api.HTTPDeletePipelineRequest](body)
+	if err != nil {
+		return nil, err
+	}
+	vc, p, err := s.pipeline(req.VirtualClusterID, req.PipelineID)
+	if err != nil {
+		return nil, err
+	}
+
+	delete(vc.pipelines, p.overview.ID)
+	return api.HTTPDeletePipelineResponse{}, nil
+}
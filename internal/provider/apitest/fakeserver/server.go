@@ -0,0 +1,295 @@
+// Package fakeserver is an in-memory stand-in for the WarpStream control plane, for tests that
+// should run without credentials or network access.
+//
+// It serves every endpoint the provider's API client calls, keeps all state in memory and mimics
+// the quirks the client depends on: names are stored with their `vcn_`, `akn_` and `ccn_` prefixes
+// even though requests send them without, and mutations that return nothing answer `{}`. It does
+// not try to reproduce the control plane's validation beyond what the provider's tests exercise.
+package fakeserver
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/warpstreamlabs/terraform-provider-warpstream/internal/provider/api"
+)
+
+// APIPath is the path every endpoint is served under, matching the production base URL.
+const APIPath = "/api/v1"
+
+// DefaultWorkspaceName is the name of the workspace the server starts with.
+const DefaultWorkspaceName = "default"
+
+// handler serves one endpoint. It is called with the server's lock held, and returns the value to
+// encode as the response body or an error to report.
+type handler func(body []byte) (any, error)
+
+// Server is a fake control plane. Create one with New and Close it when done.
+type Server struct {
+	httpServer *httptest.Server
+	routes     map[string]handler
+
+	mu sync.Mutex
+	// now returns the current time. Tests may replace it before the first request.
+	now    func() time.Time
+	nextID int
+	// idempotent holds the responses to creates that carried an Idempotency-Key, so that a
+	// retried create is answered with the object the first attempt made.
+	idempotent map[string][]byte
+
+	defaultWorkspaceID string
+	workspaces         map[string]*api.Workspace
+	apiKeys            map[string]*api.APIKey
+	userRoles          map[string]*api.UserRole
+	sso                *api.SSOConfiguration
+	clusters           map[string]*virtualCluster
+}
+
+// New starts a fake control plane seeded with a default workspace holding a `vcn_default` cluster.
+func New() *Server {
+	s := &Server{
+		now:        time.Now,
+		idempotent: map[string][]byte{},
+		workspaces: map[string]*api.Workspace{},
+		apiKeys:    map[string]*api.APIKey{},
+		userRoles:  map[string]*api.UserRole{},
+		clusters:   map[string]*virtualCluster{},
+	}
+	s.routes = s.newRoutes()
+
+	ws := s.addWorkspace(DefaultWorkspaceName)
+	s.defaultWorkspaceID = ws.ID
+	s.addVirtualCluster(ws.ID, virtualClusterParams{name: "default", tier: "dev"})
+
+	s.httpServer = httptest.NewServer(s)
+	return s
+}
+
+func (s *Server) newRoutes() map[string]handler {
+	return map[string]handler{
+		"create_workspace": s.createWorkspace,
+		"delete_workspace": s.deleteWorkspace,
+		"list_workspaces":  s.listWorkspaces,
+		"rename_workspace": s.renameWorkspace,
+
+		"create_api_key": s.createAPIKey,
+		"delete_api_key": s.deleteAPIKey,
+		"list_api_keys":  s.listAPIKeys,
+
+		"create_user_role": s.createUserRole,
+		"update_user_role": s.updateUserRole,
+		"delete_user_role": s.deleteUserRole,
+		"list_user_roles":  s.listUserRoles,
+
+		"create_sso_configuration": s.createSSOConfiguration,
+		"update_sso_configuration": s.updateSSOConfiguration,
+		"delete_sso_configuration": s.deleteSSOConfiguration,
+		"get_sso_configuration":    s.getSSOConfiguration,
+
+		"create_virtual_cluster":                 s.createVirtualCluster,
+		"describe_virtual_cluster":               s.describeVirtualCluster,
+		"list_virtual_clusters":                  s.listVirtualClusters,
+		"rename_virtual_cluster":                 s.renameVirtualCluster,
+		"delete_virtual_cluster":                 s.deleteVirtualCluster,
+		"update_virtual_cluster_tier":            s.updateVirtualClusterTier,
+		"describe_virtual_cluster_configuration": s.describeConfiguration,
+		"update_virtual_cluster_configuration":   s.updateConfiguration,
+		"describe_virtual_cluster_tags":          s.describeTags,
+		"update_virtual_cluster_tags":            s.updateTags,
+		"get_events_state":                       s.getEventsState,
+		"update_events_state":                    s.updateEventsState,
+
+		"create_virtual_cluster_credentials": s.createCredentials,
+		"delete_virtual_cluster_credentials": s.deleteCredentials,
+		"list_virtual_cluster_credentials":   s.listCredentials,
+
+		"create_topic":   s.createTopic,
+		"describe_topic": s.describeTopic,
+		"list_topics":    s.listTopics,
+		"update_topic":   s.updateTopic,
+		"delete_topic":   s.deleteTopic,
+
+		"virtual_clusters/acls/create": s.createACL,
+		"virtual_clusters/acls/list":   s.listACLs,
+		"virtual_clusters/acls/delete": s.deleteACLs,
+
+		"create_pipeline":               s.createPipeline,
+		"list_pipelines":                s.listPipelines,
+		"describe_pipeline":             s.describePipeline,
+		"create_pipeline_configuration": s.createPipelineConfiguration,
+		"change_pipeline_state":         s.changePipelineState,
+		"delete_pipeline":               s.deletePipeline,
+
+		"list_client_metrics_subscriptions":    s.listClientMetricsSubscriptions,
+		"describe_client_metrics_subscription": s.describeClientMetricsSubscription,
+		"update_client_metrics_subscriptions":  s.updateClientMetricsSubscriptions,
+		"delete_client_metrics_subscriptions":  s.deleteClientMetricsSubscriptions,
+
+		"create_workload_identity_federation": s.createWorkloadIdentityFederation,
+		"list_workload_identity_federations":  s.listWorkloadIdentityFederations,
+		"delete_workload_identity_federation": s.deleteWorkloadIdentityFederation,
+	}
+}
+
+// URL returns the base URL to point the provider at, i.e. the value for `base_url` or
+// WARPSTREAM_API_URL.
+func (s *Server) URL() string {
+	return s.httpServer.URL + APIPath
+}
+
+// DefaultWorkspaceID returns the ID of the workspace the server was seeded with. Clusters and keys
+// created without a workspace land in it.
+func (s *Server) DefaultWorkspaceID() string {
+	return s.defaultWorkspaceID
+}
+
+// Close shuts the server down.
+func (s *Server) Close() {
+	s.httpServer.Close()
+}
+
+// ServeHTTP implements http.Handler.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeError(w, &apiError{http.StatusMethodNotAllowed, "method_not_allowed", "only POST is supported"})
+		return
+	}
+	if r.Header.Get("warpstream-api-key") == "" {
+		writeError(w, &apiError{http.StatusUnauthorized, "invalid_api_key", "invalid_api_key"})
+		return
+	}
+
+	route, ok := s.routes[strings.TrimPrefix(r.URL.Path, APIPath+"/")]
+	if !ok {
+		// Not a 404, which the client would take for a missing object.
+		writeError(w, &apiError{http.StatusNotImplemented, "not_implemented", "unknown endpoint " + r.URL.Path})
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		writeError(w, badRequest("could not read request body: %v", err))
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := r.Header.Get("Idempotency-Key")
+	if cached, ok := s.idempotent[key]; ok && key != "" {
+		writeBody(w, cached)
+		return
+	}
+
+	res, err := route(body)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	out, err := json.Marshal(res)
+	if err != nil {
+		writeError(w, &apiError{http.StatusInternalServerError, "internal", err.Error()})
+		return
+	}
+	if key != "" {
+		s.idempotent[key] = out
+	}
+	writeBody(w, out)
+}
+
+// empty is the response to mutations that return nothing. It encodes as `{}`, which is what
+// several client methods check for.
+var empty = struct{}{}
+
+// apiError is an error response. Its body has the same shape as the control plane's.
+type apiError struct {
+	status  int
+	code    string
+	message string
+}
+
+func (e *apiError) Error() string {
+	return e.message
+}
+
+func notFound(format string, args ...any) error {
+	return &apiError{http.StatusNotFound, "not_found", fmt.Sprintf(format, args...)}
+}
+
+func badRequest(format string, args ...any) error {
+	return &apiError{http.StatusBadRequest, "invalid_request", fmt.Sprintf(format, args...)}
+}
+
+func conflict(code, format string, args ...any) error {
+	return &apiError{http.StatusConflict, code, fmt.Sprintf(format, args...)}
+}
+
+func writeBody(w http.ResponseWriter, body []byte) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(body)
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	var apiErr *apiError
+	if !errors.As(err, &apiErr) {
+		apiErr = &apiError{http.StatusInternalServerError, "internal", err.Error()}
+	}
+
+	body, _ := json.Marshal(map[string]string{"code": apiErr.code, "message": apiErr.message})
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(apiErr.status)
+	_, _ = w.Write(body)
+}
+
+// decode unmarshals a request body. An empty body decodes to the zero value, because list
+// endpoints are called without one.
+func decode[T any](body []byte) (T, error) {
+	var req T
+	if len(body) == 0 {
+		return req, nil
+	}
+	if err := json.Unmarshal(body, &req); err != nil {
+		return req, badRequest("invalid request body: %v", err)
+	}
+	return req, nil
+}
+
+// newID returns a new ID with the given prefix, e.g. "vci_". IDs sort in creation order.
+func (s *Server) newID(prefix string) string {
+	s.nextID++
+	return fmt.Sprintf("%s%016x", prefix, s.nextID)
+}
+
+// newSecret returns a random secret with the given prefix, e.g. "aks_".
+func newSecret(prefix string) string {
+	b := make([]byte, 20)
+	_, _ = rand.Read(b)
+	return prefix + hex.EncodeToString(b)
+}
+
+func (s *Server) timestamp() string {
+	return s.now().UTC().Format(time.RFC3339Nano)
+}
+
+// sortedValues returns the values of m ordered by key. IDs sort in creation order, so lists come
+// back the way the control plane returns them.
+func sortedValues[V any](m map[string]V) []V {
+	out := make([]V, 0, len(m))
+	for _, k := range slices.Sorted(maps.Keys(m)) {
+		out = append(out, m[k])
+	}
+	return out
+}
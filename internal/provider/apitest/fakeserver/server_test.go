@@ -0,0 +1,412 @@
+package fakeserver
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/warpstreamlabs/terraform-provider-warpstream/internal/provider/api"
+)
+
+func newTestClient(t *testing.T, s *Server, token string) *api.Client {
+	t.Helper()
+
+	opts := api.DefaultClientOptions()
+	opts.MaxRetries = 0
+	client, err := api.NewClient(s.URL(), &token, "test", opts)
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	return client
+}
+
+func startServer(t *testing.T) (*Server, *api.Client) {
+	t.Helper()
+
+	s := New()
+	t.Cleanup(s.Close)
+	return s, newTestClient(t, s, "aks_test")
+}
+
+func TestServerRequiresAPIKey(t *testing.T) {
+	t.Parallel()
+
+	s := New()
+	defer s.Close()
+
+	_, err := newTestClient(t, s, "").GetWorkspaces(t.Context())
+	if !api.IsStatus(err, http.StatusUnauthorized) {
+		t.Fatalf("expected a 401, got %v", err)
+	}
+	if !strings.Contains(err.Error(), "Did you pass an authentication token") {
+		t.Fatalf("expected the missing token hint in %q", err.Error())
+	}
+}
+
+func TestServerSeedsDefaults(t *testing.T) {
+	t.Parallel()
+
+	s, client := startServer(t)
+
+	workspaces, err := client.GetWorkspaces(t.Context())
+	if err != nil {
+		t.Fatalf("GetWorkspaces: %v", err)
+	}
+	if len(workspaces) != 1 || workspaces[0].ID != s.DefaultWorkspaceID() {
+		t.Fatalf("expected only the default workspace, got %+v", workspaces)
+	}
+
+	vc, err := client.GetDefaultCluster(t.Context())
+	if err != nil {
+		t.Fatalf("GetDefaultCluster: %v", err)
+	}
+	if vc.WorkspaceID != s.DefaultWorkspaceID() {
+		t.Fatalf("expected the default cluster in the default workspace, got %q", vc.WorkspaceID)
+	}
+}
+
+func TestServerVirtualClusterLifecycle(t *testing.T) {
+	t.Parallel()
+
+	_, client := startServer(t)
+	ctx := t.Context()
+
+	region := "us-west-2"
+	created, err := client.CreateVirtualCluster(ctx, "vcn_orders", api.ClusterParameters{
+		Type:   api.VirtualClusterTypeBYOC,
+		Tier:   api.VirtualClusterTierFundamentals,
+		Region: &region,
+		Cloud:  "aws",
+		Tags:   map[string]string{"team": "payments"},
+	})
+	if err != nil {
+		t.Fatalf("CreateVirtualCluster: %v", err)
+	}
+	if created.Name != "vcn_orders" || !strings.HasPrefix(created.ID, "vci_") {
+		t.Fatalf("expected a prefixed name and ID, got %q and %q", created.Name, created.ID)
+	}
+
+	vc, err := client.GetVirtualCluster(ctx, created.ID)
+	if err != nil {
+		t.Fatalf("GetVirtualCluster: %v", err)
+	}
+	if vc.Tier != api.VirtualClusterTierFundamentals || vc.ClusterRegion.Region.Name != region {
+		t.Fatalf("unexpected cluster %+v", vc)
+	}
+
+	tags, err := client.GetTags(ctx, *vc)
+	if err != nil {
+		t.Fatalf("GetTags: %v", err)
+	}
+	if tags["team"] != "payments" {
+		t.Fatalf("expected the create tags, got %v", tags)
+	}
+	if err := client.UpdateTags(ctx, map[string]string{"team": "orders"}, *vc); err != nil {
+		t.Fatalf("UpdateTags: %v", err)
+	}
+
+	if err := client.RenameVirtualCluster(ctx, vc.ID, "vcn_orders_v2"); err != nil {
+		t.Fatalf("RenameVirtualCluster: %v", err)
+	}
+	if _, err := client.FindVirtualCluster(ctx, "vcn_orders_v2"); err != nil {
+		t.Fatalf("FindVirtualCluster after rename: %v", err)
+	}
+
+	if err := client.DeleteVirtualCluster(ctx, vc.ID, "vcn_orders_v2"); err != nil {
+		t.Fatalf("DeleteVirtualCluster: %v", err)
+	}
+	if _, err := client.GetVirtualCluster(ctx, vc.ID); !errors.Is(err, api.ErrNotFound) {
+		t.Fatalf("expected ErrNotFound after delete, got %v", err)
+	}
+}
+
+func TestServerSchemaRegistryPrefixes(t *testing.T) {
+	t.Parallel()
+
+	_, client := startServer(t)
+
+	vc, err := client.CreateVirtualCluster(t.Context(), "vcn_sr_registry", api.ClusterParameters{
+		Type: api.VirtualClusterTypeSchemaRegistry,
+		Tier: api.VirtualClusterTierDev,
+	})
+	if err != nil {
+		t.Fatalf("CreateVirtualCluster: %v", err)
+	}
+	if vc.Name != "vcn_sr_registry" || !strings.HasPrefix(vc.ID, "vci_sr_") {
+		t.Fatalf("expected schema registry prefixes, got %q and %q", vc.Name, vc.ID)
+	}
+}
+
+func TestServerConfiguration(t *testing.T) {
+	t.Parallel()
+
+	_, client := startServer(t)
+	ctx := t.Context()
+
+	vc, err := client.GetDefaultCluster(ctx)
+	if err != nil {
+		t.Fatalf("GetDefaultCluster: %v", err)
+	}
+
+	err = client.UpdateConfiguration(ctx, api.ConfigurationUpdate{
+		EnableDeletionProtection: true,
+		BrokerConfigs: map[string]string{
+			"delete.topic.enable": "TRUE",
+			"log.retention.hours": "2",
+			"num.partitions":      "3",
+		},
+	}, *vc)
+	if err != nil {
+		t.Fatalf("UpdateConfiguration: %v", err)
+	}
+
+	cfg, err := client.GetConfiguration(ctx, *vc)
+	if err != nil {
+		t.Fatalf("GetConfiguration: %v", err)
+	}
+	if !cfg.EnableDeletionProtection || cfg.DefaultNumPartitions != 3 || cfg.DefaultRetentionMillis != 7200000 {
+		t.Fatalf("typed fields were not updated: %+v", cfg)
+	}
+	if v := cfg.BrokerConfigs["delete.topic.enable"]; v == nil || *v != "true" {
+		t.Fatalf("expected delete.topic.enable to be normalized to true, got %v", v)
+	}
+	if _, ok := cfg.BrokerConfigs["log.retention.hours"]; ok {
+		t.Fatal("expected log.retention.hours not to be reported back")
+	}
+
+	for key, want := range map[string]string{
+		"messge.max.bytes":       `unsupported cluster config "messge.max.bytes"`,
+		"warpstream.acls.enable": `unsupported cluster config "warpstream.acls.enable"`,
+		"message.max.bytes":      `invalid cluster config "message.max.bytes"`,
+	} {
+		err := client.UpdateConfiguration(ctx, api.ConfigurationUpdate{
+			BrokerConfigs: map[string]string{key: ""},
+		}, *vc)
+		if err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("expected %q for %s, got %v", want, key, err)
+		}
+	}
+
+	if err := client.DeleteVirtualCluster(ctx, vc.ID, vc.Name); err == nil || !strings.Contains(err.Error(), "deletion protection enabled") {
+		t.Fatalf("expected deletion protection to block the delete, got %v", err)
+	}
+}
+
+func TestServerTopics(t *testing.T) {
+	t.Parallel()
+
+	_, client := startServer(t)
+	ctx := t.Context()
+
+	vc, err := client.GetDefaultCluster(ctx)
+	if err != nil {
+		t.Fatalf("GetDefaultCluster: %v", err)
+	}
+
+	retention := "604800000"
+	if err := client.CreateTopic(ctx, vc.ID, "orders", 2, map[string]*string{"retention.ms": &retention}); err != nil {
+		t.Fatalf("CreateTopic: %v", err)
+	}
+	if err := client.CreateTopic(ctx, vc.ID, "orders", 2, nil); !api.IsStatus(err, http.StatusConflict) {
+		t.Fatalf("expected a 409 for a duplicate topic, got %v", err)
+	}
+
+	topic, err := client.GetTopic(ctx, vc.ID, "orders")
+	if err != nil {
+		t.Fatalf("GetTopic: %v", err)
+	}
+	if topic.PartitionCount != 2 || *topic.Configs["retention.ms"] != retention {
+		t.Fatalf("unexpected topic %+v", topic)
+	}
+
+	protected := "true"
+	partitions := 4
+	err = client.UpdateTopic(ctx, vc.ID, "orders", &partitions, map[string]*string{
+		"warpstream.deletion.protection.enabled": &protected,
+	})
+	if err != nil {
+		t.Fatalf("UpdateTopic: %v", err)
+	}
+	if err := client.DeleteTopic(ctx, vc.ID, "orders"); err == nil || !strings.Contains(err.Error(), "deletion protection enabled") {
+		t.Fatalf("expected deletion protection to block the delete, got %v", err)
+	}
+
+	if err := client.UpdateTopic(ctx, vc.ID, "orders", nil, map[string]*string{
+		"warpstream.deletion.protection.enabled": nil,
+	}); err != nil {
+		t.Fatalf("UpdateTopic: %v", err)
+	}
+	if err := client.DeleteTopic(ctx, vc.ID, "orders"); err != nil {
+		t.Fatalf("DeleteTopic: %v", err)
+	}
+	if _, err := client.DescribeTopic(ctx, vc.ID, "orders"); !errors.Is(err, api.ErrNotFound) {
+		t.Fatalf("expected ErrNotFound after delete, got %v", err)
+	}
+}
+
+func TestServerACLs(t *testing.T) {
+	t.Parallel()
+
+	_, client := startServer(t)
+	ctx := t.Context()
+
+	vc, err := client.GetDefaultCluster(ctx)
+	if err != nil {
+		t.Fatalf("GetDefaultCluster: %v", err)
+	}
+
+	acl := api.ACLRequest{
+		ResourceType:   "TOPIC",
+		ResourceName:   "orders",
+		PatternType:    "LITERAL",
+		Principal:      "User:ccun_app",
+		Host:           "*",
+		Operation:      "READ",
+		PermissionType: "ALLOW",
+	}
+	if _, err := client.CreateACL(ctx, vc.ID, acl); err != nil {
+		t.Fatalf("CreateACL: %v", err)
+	}
+	if _, err := client.GetACL(ctx, vc.ID, acl); err != nil {
+		t.Fatalf("GetACL: %v", err)
+	}
+	if err := client.DeleteACL(ctx, vc.ID, acl); err != nil {
+		t.Fatalf("DeleteACL: %v", err)
+	}
+
+	acls, err := client.ListACLs(ctx, vc.ID)
+	if err != nil {
+		t.Fatalf("ListACLs: %v", err)
+	}
+	if len(acls) != 0 {
+		t.Fatalf("expected no ACLs after delete, got %+v", acls)
+	}
+}
+
+func TestServerAPIKeys(t *testing.T) {
+	t.Parallel()
+
+	s, client := startServer(t)
+	ctx := t.Context()
+
+	key, err := client.CreateApplicationKey(ctx, "akn_ci", s.DefaultWorkspaceID(), false)
+	if err != nil {
+		t.Fatalf("CreateApplicationKey: %v", err)
+	}
+	if key.Name != "akn_ci" || !strings.HasPrefix(key.Key, "aks_") {
+		t.Fatalf("unexpected key %+v", key)
+	}
+
+	_, err = client.CreateApplicationKey(ctx, "akn_ci", s.DefaultWorkspaceID(), false)
+	var apiErr *api.APIError
+	if !errors.As(err, &apiErr) || apiErr.Code != "duplicate_api_key_name" {
+		t.Fatalf("expected duplicate_api_key_name, got %v", err)
+	}
+
+	_, err = client.CreateApplicationKey(ctx, "akn_other", "wi_not_exist", false)
+	if err == nil || !strings.Contains(err.Error(), "workspace not found.") {
+		t.Fatalf("expected workspace not found, got %v", err)
+	}
+
+	listed, err := client.GetAPIKey(ctx, key.ID)
+	if err != nil {
+		t.Fatalf("GetAPIKey: %v", err)
+	}
+	if listed.Key != "" {
+		t.Fatal("expected the secret to be returned on creation only")
+	}
+
+	if err := client.DeleteAPIKey(ctx, key.ID); err != nil {
+		t.Fatalf("DeleteAPIKey: %v", err)
+	}
+}
+
+func TestServerPipelines(t *testing.T) {
+	t.Parallel()
+
+	_, client := startServer(t)
+	ctx := t.Context()
+
+	vc, err := client.GetDefaultCluster(ctx)
+	if err != nil {
+		t.Fatalf("GetDefaultCluster: %v", err)
+	}
+
+	created, err := client.CreatePipeline(ctx, api.HTTPCreatePipelineRequest{
+		VirtualClusterID: vc.ID,
+		PipelineName:     "mirror",
+		Type:             "bento",
+	})
+	if err != nil {
+		t.Fatalf("CreatePipeline: %v", err)
+	}
+
+	cfg, err := client.CreatePipelineConfiguration(ctx, api.HTTPCreatePipelineConfigurationRequest{
+		VirtualClusterID:  vc.ID,
+		PipelineID:        created.PipelineID,
+		ConfigurationYAML: "input: {}",
+	})
+	if err != nil {
+		t.Fatalf("CreatePipelineConfiguration: %v", err)
+	}
+
+	running := "running"
+	if _, err := client.ChangePipelineState(ctx, api.HTTPChangePipelineStateRequest{
+		VirtualClusterID:        vc.ID,
+		PipelineID:              created.PipelineID,
+		DesiredState:            &running,
+		DeployedConfigurationID: &cfg.ConfigurationID,
+	}); err != nil {
+		t.Fatalf("ChangePipelineState: %v", err)
+	}
+
+	described, err := client.DescribePipeline(ctx, api.HTTPDescribePipelineRequest{
+		VirtualClusterID: vc.ID,
+		PipelineID:       created.PipelineID,
+	})
+	if err != nil {
+		t.Fatalf("DescribePipeline: %v", err)
+	}
+	if described.PipelineOverview.State != running || described.PipelineOverview.DeployedConfigurationId != cfg.ConfigurationID {
+		t.Fatalf("unexpected pipeline %+v", described.PipelineOverview)
+	}
+
+	if _, err := client.DeletePipeline(ctx, api.HTTPDeletePipelineRequest{
+		VirtualClusterID: vc.ID,
+		PipelineID:       created.PipelineID,
+	}); err != nil {
+		t.Fatalf("DeletePipeline: %v", err)
+	}
+}
+
+func TestServerReplaysIdempotentCreates(t *testing.T) {
+	t.Parallel()
+
+	s := New()
+	defer s.Close()
+
+	send := func() *http.Response {
+		req, err := http.NewRequestWithContext(t.Context(), http.MethodPost, s.URL()+"/create_workspace",
+			strings.NewReader(`{"workspace_name":"retried"}`))
+		if err != nil {
+			t.Fatal(err)
+		}
+		req.Header.Set("warpstream-api-key", "aks_test")
+		req.Header.Set("Idempotency-Key", "key-1")
+		res, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		t.Cleanup(func() { _ = res.Body.Close() })
+		return res
+	}
+	send()
+	send()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if got := len(s.workspaces); got != 2 {
+		t.Fatalf("expected one workspace besides the default, got %d", got-1)
+	}
+}
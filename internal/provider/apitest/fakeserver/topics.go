@@ -0,0 +1,242 @@
+package fakeserver
+
+import (
+	"maps"
+	"slices"
+	"strconv"
+
+	"github.com/warpstreamlabs/terraform-provider-warpstream/internal/provider/api"
+)
+
+// topicDeletionProtectionConfig is the topic config that blocks deleting the topic, or the cluster
+// holding it, while it is "true".
+const topicDeletionProtectionConfig = "warpstream.deletion.protection.enabled"
+
+// topicDefaults are the configs every topic reports until they are overridden. retention.ms comes
+// from the cluster's default_retention_millis instead.
+var topicDefaults = map[string]string{
+	"cleanup.policy": "delete",
+}
+
+func topicDeletionProtected(topic *api.Topic) bool {
+	v, ok := topic.Configs[topicDeletionProtectionConfig]
+	if !ok || v == nil {
+		return false
+	}
+	protected, _ := strconv.ParseBool(*v)
+	return protected
+}
+
+// applyTopicConfigs writes configs onto a topic. A nil value resets the config to its default.
+func applyTopicConfigs(vc *virtualCluster, topic *api.Topic, configs map[string]*string) {
+	for key, value := range configs {
+		if value != nil {
+			v := *value
+			topic.Configs[key] = &v
+			continue
+		}
+
+		delete(topic.Configs, key)
+		if v, ok := topicDefaults[key]; ok {
+			topic.Configs[key] = &v
+		}
+		if key == "retention.ms" {
+			v := strconv.FormatInt(vc.config.DefaultRetentionMillis, 10)
+			topic.Configs[key] = &v
+		}
+	}
+}
+
+func cloneTopic(topic *api.Topic) api.Topic {
+	out := *topic
+	out.Configs = maps.Clone(topic.Configs)
+	return out
+}
+
+func (s *Server) topic(vcID, name string) (*virtualCluster, *api.Topic, error) {
+	vc, err := s.cluster(vcID)
+	if err != nil {
+		return nil, nil, err
+	}
+	topic, ok := vc.topics[name]
+	if !ok {
+		return nil, nil, notFound("topic %s not found.", name)
+	}
+	return vc, topic, nil
+}
+
+func (s *Server) createTopic(body []byte) (any, error) {
+	req, err := decode[api.TopicCreateRequest](body)
+	if err != nil {
+		return nil, err
+	}
+	vc, err := s.cluster(req.VirtualClusterID)
+	if err != nil {
+		return nil, err
+	}
+	if req.TopicName == "" {
+		return nil, badRequest("topic name is required")
+	}
+	if _, ok := vc.topics[req.TopicName]; ok {
+		return nil, conflict("topic_already_exists", "topic %s already exists", req.TopicName)
+	}
+	if req.PartitionCount < 0 {
+		return nil, badRequest("invalid partition count %d", req.PartitionCount)
+	}
+
+	partitions := req.PartitionCount
+	if partitions == 0 {
+		partitions = int(vc.config.DefaultNumPartitions)
+	}
+	retention := strconv.FormatInt(vc.config.DefaultRetentionMillis, 10)
+	topic := &api.Topic{
+		TopicName:      req.TopicName,
+		PartitionCount: partitions,
+		Configs:        map[string]*string{"retention.ms": &retention},
+	}
+	for k, v := range topicDefaults {
+		topic.Configs[k] = &v
+	}
+	applyTopicConfigs(vc, topic, req.Configs)
+
+	vc.topics[topic.TopicName] = topic
+	return empty, nil
+}
+
+func (s *Server) describeTopic(body []byte) (any, error) {
+	req, err := decode[api.TopicDescribeRequest](body)
+	if err != nil {
+		return nil, err
+	}
+	_, topic, err := s.topic(req.VirtualClusterID, req.TopicName)
+	if err != nil {
+		return nil, err
+	}
+
+	return api.TopicDescribeResponse{
+		PartitionCount: topic.PartitionCount,
+		Configs:        maps.Clone(topic.Configs),
+	}, nil
+}
+
+func (s *Server) listTopics(body []byte) (any, error) {
+	req, err := decode[api.TopicListRequest](body)
+	if err != nil {
+		return nil, err
+	}
+	vc, err := s.cluster(req.VirtualClusterID)
+	if err != nil {
+		return nil, err
+	}
+
+	topics := make([]api.Topic, 0, len(vc.topics))
+	for _, topic := range sortedValues(vc.topics) {
+		listed := cloneTopic(topic)
+		if !req.IncludeConfigs {
+			listed.Configs = nil
+		}
+		topics = append(topics, listed)
+	}
+	return api.TopicListResponse{Topics: topics}, nil
+}
+
+func (s *Server) updateTopic(body []byte) (any, error) {
+	req, err := decode[api.TopicUpdateRequest](body)
+	if err != nil {
+		return nil, err
+	}
+	vc, topic, err := s.topic(req.VirtualClusterID, req.TopicName)
+	if err != nil {
+		return nil, err
+	}
+	if req.PartitionCount != nil && *req.PartitionCount < topic.PartitionCount {
+		return nil, badRequest("cannot decrease partition count of topic %s from %d to %d",
+			topic.TopicName, topic.PartitionCount, *req.PartitionCount)
+	}
+
+	if req.PartitionCount != nil {
+		topic.PartitionCount = *req.PartitionCount
+	}
+	applyTopicConfigs(vc, topic, req.Configs)
+	return empty, nil
+}
+
+func (s *Server) deleteTopic(body []byte) (any, error) {
+	req, err := decode[api.TopicDeleteRequest](body)
+	if err != nil {
+		return nil, err
+	}
+	vc, topic, err := s.topic(req.VirtualClusterID, req.TopicName)
+	if err != nil {
+		return nil, err
+	}
+	if topicDeletionProtected(topic) {
+		return nil, badRequest("cannot delete topic %s: deletion protection enabled", topic.TopicName)
+	}
+
+	delete(vc.topics, topic.TopicName)
+	return empty, nil
+}
+
+func (s *Server) createACL(body []byte) (any, error) {
+	req, err := decode[api.ACLCreateRequest](body)
+	if err != nil {
+		return nil, err
+	}
+	vc, err := s.cluster(req.VirtualClusterID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Creating an ACL that already exists succeeds without adding a second copy, as in Kafka.
+	acl := api.ACLResponse(req.ACL)
+	if !slices.Contains(vc.acls, acl) {
+		vc.acls = append(vc.acls, acl)
+	}
+	return api.ACLDescribeResponse{ACL: acl}, nil
+}
+
+func (s *Server) listACLs(body []byte) (any, error) {
+	req, err := decode[api.ACLListRequest](body)
+	if err != nil {
+		return nil, err
+	}
+	vc, err := s.cluster(req.VirtualClusterID)
+	if err != nil {
+		return nil, err
+	}
+
+	return api.ACLListResponse{ACLs: append([]api.ACLResponse{}, vc.acls...)}, nil
+}
+
+// deleteACLs deletes every listed ACL that exists and reports the ones it deleted. ACLs that do
+// not exist are ignored, as Kafka's DeleteAcls does.
+func (s *Server) deleteACLs(body []byte) (any, error) {
+	req, err := decode[api.ACLDeleteRequest](body)
+	if err != nil {
+		return nil, err
+	}
+	vc, err := s.cluster(req.VirtualClusterID)
+	if err != nil {
+		return nil, err
+	}
+
+	deleted := []api.ACLResponse{}
+	kept := vc.acls[:0]
+	for _, acl := range vc.acls {
+		matched := false
+		for _, target := range req.ACLs {
+			if acl == api.ACLResponse(target) {
+				matched = true
+				break
+			}
+		}
+		if matched {
+			deleted = append(deleted, acl)
+		} else {
+			kept = append(kept, acl)
+		}
+	}
+	vc.acls = kept
+	return api.ACLDeleteResponse{ACLs: deleted}, nil
+}
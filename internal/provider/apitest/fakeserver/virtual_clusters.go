@@ -0,0 +1,438 @@
+package fakeserver
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/warpstreamlabs/terraform-provider-warpstream/internal/provider/api"
+)
+
+// defaultRegion is the region a cluster lands in when the create request names neither a region
+// nor a region group.
+const defaultRegion = "us-east-1"
+
+// defaultEventRetention is the retention of an event type that was never configured.
+const defaultEventRetention = uint64(24 * time.Hour)
+
+// eventTypes are the event types every cluster reports, whether or not they were configured.
+var eventTypes = []string{"agent_logs", "acl_logs", "pipeline_logs"}
+
+// clusterPrefixes are the name and ID prefixes of each virtual cluster type.
+var clusterPrefixes = map[string]struct{ name, id string }{
+	api.VirtualClusterTypeBYOC:           {"vcn_", "vci_"},
+	api.VirtualClusterTypeSchemaRegistry: {"vcn_sr_", "vci_sr_"},
+	api.VirtualClusterTypeTableFlow:      {"vcn_dl_", "vci_dl_"},
+}
+
+var clusterTiers = []string{
+	api.VirtualClusterTierLegacy,
+	api.VirtualClusterTierDev,
+	api.VirtualClusterTierFundamentals,
+	api.VirtualClusterTierPro,
+	api.VirtualClusterTierEnterprise,
+}
+
+type virtualCluster struct {
+	api.VirtualCluster
+
+	config api.VirtualClusterConfiguration
+	// brokerConfigs holds the broker configs written through the configuration's broker_configs,
+	// as the API reports them back.
+	brokerConfigs map[string]string
+	tags          map[string]string
+	events        api.EventsState
+
+	credentials   map[string]*api.VirtualClusterCredentials
+	topics        map[string]*api.Topic
+	acls          []api.ACLResponse
+	pipelines     map[string]*pipeline
+	subscriptions map[string]api.ClientMetricsSubscription
+	federations   map[string]*api.WorkloadIdentityFederation
+}
+
+type virtualClusterParams struct {
+	// name is the name without its type prefix, as the create request carries it.
+	name          string
+	clusterType   string
+	tier          string
+	region        *string
+	regionGroup   *string
+	cloudProvider string
+	tags          map[string]string
+}
+
+func (s *Server) addVirtualCluster(workspaceID string, p virtualClusterParams) *virtualCluster {
+	if p.clusterType == "" {
+		p.clusterType = api.VirtualClusterTypeBYOC
+	}
+	if p.cloudProvider == "" {
+		p.cloudProvider = "aws"
+	}
+	if p.tier == "" {
+		p.tier = api.VirtualClusterTierDev
+	}
+
+	var region api.ClusterRegion
+	if p.regionGroup != nil {
+		region.IsMultiRegion = true
+		region.RegionGroup = &api.RegionGroup{Name: *p.regionGroup}
+	} else {
+		name := defaultRegion
+		if p.region != nil {
+			name = *p.region
+		}
+		region.Region = &api.Region{Name: name, CloudProvider: p.cloudProvider}
+	}
+
+	prefixes := clusterPrefixes[p.clusterType]
+	id := s.newID(prefixes.id)
+	bootstrapURL := fmt.Sprintf("%s.kafka.discovery.%s.warpstream.test:9092", id, defaultRegion)
+	ttl := 24 * time.Hour
+
+	vc := &virtualCluster{
+		VirtualCluster: api.VirtualCluster{
+			ID:            id,
+			Name:          prefixes.name + p.name,
+			Type:          p.clusterType,
+			AgentPoolID:   s.newID("api_"),
+			AgentPoolName: "apn_" + p.name,
+			CreatedAt:     s.timestamp(),
+			CloudProvider: p.cloudProvider,
+			ClusterRegion: region,
+			BootstrapURL:  &bootstrapURL,
+			WorkspaceID:   workspaceID,
+			Tier:          p.tier,
+		},
+		config: api.VirtualClusterConfiguration{
+			AutoCreateTopic:         true,
+			DefaultNumPartitions:    1,
+			DefaultRetentionMillis:  86400000,
+			DefaultTopicType:        "classic",
+			EnableSoftTopicDeletion: true,
+			SoftTopicDeletionTTL:    &ttl,
+		},
+		brokerConfigs: map[string]string{},
+		tags:          map[string]string{},
+		events:        api.EventsState{EventTypes: map[string]api.EventTypeConfig{}},
+		credentials:   map[string]*api.VirtualClusterCredentials{},
+		topics:        map[string]*api.Topic{},
+		pipelines:     map[string]*pipeline{},
+		subscriptions: map[string]api.ClientMetricsSubscription{},
+		federations:   map[string]*api.WorkloadIdentityFederation{},
+	}
+	maps.Copy(vc.tags, p.tags)
+	for _, eventType := range eventTypes {
+		enabled := false
+		retention := defaultEventRetention
+		vc.events.EventTypes[eventType] = api.EventTypeConfig{Enabled: &enabled, RetentionPeriodNanos: &retention}
+	}
+
+	s.clusters[vc.ID] = vc
+	return vc
+}
+
+// cluster returns the virtual cluster with the given ID.
+func (s *Server) cluster(id string) (*virtualCluster, error) {
+	vc, ok := s.clusters[id]
+	if !ok {
+		return nil, notFound("virtual cluster %s not found.", id)
+	}
+	return vc, nil
+}
+
+// describe returns the cluster as the describe and list endpoints report it.
+func (s *Server) describe(vc *virtualCluster) api.VirtualCluster {
+	out := vc.VirtualCluster
+	agentKeys := s.agentKeys(vc.ID)
+	out.AgentKeys = &agentKeys
+	return out
+}
+
+func (s *Server) createVirtualCluster(body []byte) (any, error) {
+	req, err := decode[api.VirtualClusterCreateRequest](body)
+	if err != nil {
+		return nil, err
+	}
+
+	clusterType := req.Type
+	if clusterType == "" {
+		clusterType = api.VirtualClusterTypeBYOC
+	}
+	prefixes, ok := clusterPrefixes[clusterType]
+	if !ok {
+		return nil, badRequest("unsupported virtual cluster type %q", req.Type)
+	}
+	if req.Name == "" || strings.HasPrefix(req.Name, "vcn_") {
+		return nil, badRequest("invalid virtual cluster name %q", req.Name)
+	}
+	if req.Tier != "" && !slices.Contains(clusterTiers, req.Tier) {
+		return nil, badRequest("unsupported virtual cluster tier %q", req.Tier)
+	}
+	if req.Region != nil && req.RegionGroup != nil {
+		return nil, badRequest("only one of region and region group can be set")
+	}
+	for _, vc := range s.clusters {
+		if vc.Name == prefixes.name+req.Name {
+			return nil, conflict("duplicate_virtual_cluster_name", "a virtual cluster named %s already exists", vc.Name)
+		}
+	}
+
+	vc := s.addVirtualCluster(s.defaultWorkspaceID, virtualClusterParams{
+		name:          req.Name,
+		clusterType:   clusterType,
+		tier:          req.Tier,
+		region:        req.Region,
+		regionGroup:   req.RegionGroup,
+		cloudProvider: req.CloudProvider,
+		tags:          req.Tags,
+	})
+
+	res := api.VirtualClusterCreateResponse{
+		VirtualClusterID: vc.ID,
+		AgentPoolID:      vc.AgentPoolID,
+		AgentPoolName:    vc.AgentPoolName,
+		Name:             vc.Name,
+		BootstrapURL:     vc.BootstrapURL,
+		WorkspaceID:      vc.WorkspaceID,
+	}
+	if !req.SkipAgentKeyCreation {
+		res.AgentKey = *s.addAPIKey("akn_agent_"+req.Name, api.AccessGrant{
+			PrincipalKind: api.PrincipalKindAgent,
+			ResourceKind:  api.ResourceKindVirtualCluster,
+			ResourceID:    vc.ID,
+			WorkspaceID:   vc.WorkspaceID,
+		})
+	}
+	return res, nil
+}
+
+func (s *Server) describeVirtualCluster(body []byte) (any, error) {
+	req, err := decode[api.VirtualClusterDescribeRequest](body)
+	if err != nil {
+		return nil, err
+	}
+	vc, err := s.cluster(req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return api.VirtualClusterDescribeResponse{VirtualCluster: s.describe(vc)}, nil
+}
+
+func (s *Server) listVirtualClusters([]byte) (any, error) {
+	vcs := make([]api.VirtualCluster, 0, len(s.clusters))
+	for _, vc := range sortedValues(s.clusters) {
+		vcs = append(vcs, s.describe(vc))
+	}
+	return api.VirtualClusterListResponse{VirtualClusters: vcs}, nil
+}
+
+func (s *Server) renameVirtualCluster(body []byte) (any, error) {
+	req, err := decode[api.VirtualClusterRenameRequest](body)
+	if err != nil {
+		return nil, err
+	}
+	vc, err := s.cluster(req.ID)
+	if err != nil {
+		return nil, err
+	}
+	if req.NewName == "" {
+		return nil, badRequest("invalid virtual cluster name %q", req.NewName)
+	}
+
+	vc.Name = "vcn_" + req.NewName
+	return empty, nil
+}
+
+func (s *Server) deleteVirtualCluster(body []byte) (any, error) {
+	req, err := decode[api.VirtualClusterDeleteRequest](body)
+	if err != nil {
+		return nil, err
+	}
+	vc, err := s.cluster(req.ID)
+	if err != nil {
+		return nil, err
+	}
+	if req.Name != vc.Name {
+		return nil, badRequest("virtual cluster %s is named %s, not %s", vc.ID, vc.Name, req.Name)
+	}
+	if vc.config.EnableDeletionProtection {
+		return nil, badRequest("cannot delete virtual cluster %s: deletion protection enabled", vc.Name)
+	}
+	for _, topic := range sortedValues(vc.topics) {
+		if topicDeletionProtected(topic) {
+			return nil, badRequest("cannot delete virtual cluster %s: topic %s has deletion protection enabled", vc.Name, topic.TopicName)
+		}
+	}
+
+	delete(s.clusters, vc.ID)
+	for id, key := range s.apiKeys {
+		if key.AccessGrants[0].ResourceID == vc.ID {
+			delete(s.apiKeys, id)
+		}
+	}
+	return empty, nil
+}
+
+func (s *Server) updateVirtualClusterTier(body []byte) (any, error) {
+	req, err := decode[api.VirtualClusterUpdateTierRequest](body)
+	if err != nil {
+		return nil, err
+	}
+	vc, err := s.cluster(req.VirtualClusterID)
+	if err != nil {
+		return nil, err
+	}
+	if !slices.Contains(clusterTiers, req.Tier) {
+		return nil, badRequest("unsupported virtual cluster tier %q", req.Tier)
+	}
+
+	vc.Tier = req.Tier
+	return empty, nil
+}
+
+func (s *Server) describeTags(body []byte) (any, error) {
+	req, err := decode[api.TagsDescribeRequest](body)
+	if err != nil {
+		return nil, err
+	}
+	vc, err := s.cluster(req.VirtualClusterID)
+	if err != nil {
+		return nil, err
+	}
+
+	return api.TagsDescribeResponse{Tags: maps.Clone(vc.tags)}, nil
+}
+
+func (s *Server) updateTags(body []byte) (any, error) {
+	req, err := decode[api.TagsUpdateRequest](body)
+	if err != nil {
+		return nil, err
+	}
+	vc, err := s.cluster(req.VirtualClusterID)
+	if err != nil {
+		return nil, err
+	}
+
+	vc.tags = map[string]string{}
+	maps.Copy(vc.tags, req.Tags)
+	return empty, nil
+}
+
+func (s *Server) getEventsState(body []byte) (any, error) {
+	req, err := decode[api.EventsStateDescribeRequest](body)
+	if err != nil {
+		return nil, err
+	}
+	vc, err := s.cluster(req.VirtualClusterID)
+	if err != nil {
+		return nil, err
+	}
+
+	return api.EventsStateDescribeResponse{
+		Enabled:    vc.events.Enabled,
+		EventTypes: maps.Clone(vc.events.EventTypes),
+	}, nil
+}
+
+func (s *Server) updateEventsState(body []byte) (any, error) {
+	req, err := decode[api.EventsStateUpdateRequest](body)
+	if err != nil {
+		return nil, err
+	}
+	vc, err := s.cluster(req.VirtualClusterID)
+	if err != nil {
+		return nil, err
+	}
+	for eventType := range req.EventTypes {
+		if !slices.Contains(eventTypes, eventType) {
+			return nil, badRequest("unknown event type %q", eventType)
+		}
+	}
+
+	if req.Enabled != nil {
+		vc.events.Enabled = *req.Enabled
+	}
+	for eventType, update := range req.EventTypes {
+		current := vc.events.EventTypes[eventType]
+		if update.Enabled != nil {
+			current.Enabled = update.Enabled
+		}
+		if update.RetentionPeriodNanos != nil {
+			current.RetentionPeriodNanos = update.RetentionPeriodNanos
+		}
+		vc.events.EventTypes[eventType] = current
+	}
+	return empty, nil
+}
+
+func (s *Server) createCredentials(body []byte) (any, error) {
+	req, err := decode[api.CredentialsCreateRequest](body)
+	if err != nil {
+		return nil, err
+	}
+	vc, err := s.cluster(req.VirtualClusterID)
+	if err != nil {
+		return nil, err
+	}
+
+	name := "ccn_" + req.Name
+	for _, creds := range vc.credentials {
+		if creds.Name == name {
+			return nil, conflict("duplicate_credentials_name", "credentials named %s already exist", name)
+		}
+	}
+
+	password := newSecret("ccp_")
+	if req.ImportedPassword != nil {
+		password = *req.ImportedPassword
+	}
+	creds := &api.VirtualClusterCredentials{
+		ID:               s.newID("cci_"),
+		Name:             name,
+		UserName:         newSecret("ccun_"),
+		CreatedAt:        s.timestamp(),
+		ClusterSuperuser: req.ClusterSuperuser,
+		ReadOnly:         req.ReadOnly,
+	}
+	vc.credentials[creds.ID] = creds
+
+	return api.CredentialsCreateResponse{ID: creds.ID, UserName: creds.UserName, Password: password}, nil
+}
+
+func (s *Server) deleteCredentials(body []byte) (any, error) {
+	req, err := decode[api.CredentialsDeleteRequest](body)
+	if err != nil {
+		return nil, err
+	}
+	vc, err := s.cluster(req.VirtualClusterID)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := vc.credentials[req.ID]; !ok {
+		return nil, notFound("credentials not found.")
+	}
+
+	delete(vc.credentials, req.ID)
+	return empty, nil
+}
+
+func (s *Server) listCredentials(body []byte) (any, error) {
+	req, err := decode[api.CredentialsListRequest](body)
+	if err != nil {
+		return nil, err
+	}
+	vc, err := s.cluster(req.VirtualClusterID)
+	if err != nil {
+		return nil, err
+	}
+
+	creds := make([]api.VirtualClusterCredentials, 0, len(vc.credentials))
+	for _, c := range sortedValues(vc.credentials) {
+		creds = append(creds, *c)
+	}
+	return api.CredentialsListResponse{Credentials: creds}, nil
+}
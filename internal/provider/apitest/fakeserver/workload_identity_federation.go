@@ -0,0 +1,100 @@
+package fakeserver
+
+import (
+	"slices"
+
+	"github.com/warpstreamlabs/terraform-provider-warpstream/internal/provider/api"
+)
+
+// The API package keeps its workload identity federation request types unexported, so their
+// shapes are repeated here.
+
+type createWorkloadIdentityFederationRequest struct {
+	VirtualClusterID        string           `json:"virtual_cluster_id"`
+	Name                    string           `json:"name"`
+	IssuerURL               string           `json:"issuer_url"`
+	ClaimMatchRules         []api.ClaimMatch `json:"claim_match_rules"`
+	ReadOnly                bool             `json:"read_only"`
+	MaxCredentialTTLSeconds int64            `json:"max_credential_ttl_seconds"`
+}
+
+type listWorkloadIdentityFederationsRequest struct {
+	VirtualClusterID string `json:"virtual_cluster_id"`
+}
+
+type listWorkloadIdentityFederationsResponse struct {
+	WorkloadIdentityFederations []api.WorkloadIdentityFederation `json:"workload_identity_federations"`
+}
+
+type deleteWorkloadIdentityFederationRequest struct {
+	VirtualClusterID string `json:"virtual_cluster_id"`
+	ID               string `json:"id"`
+}
+
+func (s *Server) createWorkloadIdentityFederation(body []byte) (any, error) {
+	req, err := decode[createWorkloadIdentityFederationRequest](body)
+	if err != nil {
+		return nil, err
+	}
+	vc, err := s.cluster(req.VirtualClusterID)
+	if err != nil {
+		return nil, err
+	}
+	if req.Name == "" || req.IssuerURL == "" {
+		return nil, badRequest("name and issuer_url are required")
+	}
+	for _, fed := range vc.federations {
+		if fed.Name == req.Name {
+			return nil, conflict("duplicate_workload_identity_federation_name",
+				"a workload identity federation named %s already exists", req.Name)
+		}
+	}
+
+	fed := &api.WorkloadIdentityFederation{
+		ID:                      s.newID("wif_"),
+		VirtualClusterID:        vc.ID,
+		Name:                    req.Name,
+		IssuerURL:               req.IssuerURL,
+		Audience:                vc.ID,
+		ClaimMatchRules:         slices.Clone(req.ClaimMatchRules),
+		ReadOnly:                req.ReadOnly,
+		MaxCredentialTTLSeconds: req.MaxCredentialTTLSeconds,
+		CreatedAt:               s.timestamp(),
+	}
+	vc.federations[fed.ID] = fed
+	return fed, nil
+}
+
+func (s *Server) listWorkloadIdentityFederations(body []byte) (any, error) {
+	req, err := decode[listWorkloadIdentityFederationsRequest](body)
+	if err != nil {
+		return nil, err
+	}
+	vc, err := s.cluster(req.VirtualClusterID)
+	if err != nil {
+		return nil, err
+	}
+
+	feds := make([]api.WorkloadIdentityFederation, 0, len(vc.federations))
+	for _, fed := range sortedValues(vc.federations) {
+		feds = append(feds, *fed)
+	}
+	return listWorkloadIdentityFederationsResponse{WorkloadIdentityFederations: feds}, nil
+}
+
+func (s *Server) deleteWorkloadIdentityFederation(body []byte) (any, error) {
+	req, err := decode[deleteWorkloadIdentityFederationRequest](body)
+	if err != nil {
+		return nil, err
+	}
+	vc, err := s.cluster(req.VirtualClusterID)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := vc.federations[req.ID]; !ok {
+		return nil, notFound("workload identity federation %s not found.", req.ID)
+	}
+
+	delete(vc.federations, req.ID)
+	return empty, nil
+}
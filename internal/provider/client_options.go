@@ -0,0 +1,144 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/warpstreamlabs/terraform-provider-warpstream/internal/provider/api"
+)
+
+// clientOptions builds the API client's retry, timeout and transport settings. Each setting
+// defaults to the client's built-in value, is overridden by its environment variable, and then by
+// the provider configuration.
+func clientOptions(config warpstreamProviderModel, diags *diag.Diagnostics) api.ClientOptions {
+	opts := api.DefaultClientOptions()
+
+	if v, ok := int64Setting(config.MaxRetries, "max_retries", "WARPSTREAM_MAX_RETRIES", diags); ok {
+		if v < 0 {
+			diags.AddAttributeError(path.Root("max_retries"), "Invalid Max Retries",
+				fmt.Sprintf("max_retries must be zero or greater, got %d.", v))
+		}
+		opts.MaxRetries = int(v)
+	}
+	if v, ok := durationSetting(config.MinRetryBackoff, "min_retry_backoff", "WARPSTREAM_MIN_RETRY_BACKOFF", diags); ok {
+		opts.RetryWaitMin = v
+	}
+	if v, ok := durationSetting(config.MaxRetryBackoff, "max_retry_backoff", "WARPSTREAM_MAX_RETRY_BACKOFF", diags); ok {
+		opts.RetryWaitMax = v
+	}
+	if v, ok := durationSetting(config.RequestTimeout, "request_timeout", "WARPSTREAM_REQUEST_TIMEOUT", diags); ok {
+		opts.RequestTimeout = v
+	}
+	if v, ok := durationSetting(config.CallTimeout, "call_timeout", "WARPSTREAM_CALL_TIMEOUT", diags); ok {
+		opts.CallTimeout = v
+	}
+
+	if v, ok := float64Setting(config.RequestsPerSecond, "requests_per_second", "WARPSTREAM_REQUESTS_PER_SECOND", diags); ok {
+		if v < 0 {
+			diags.AddAttributeError(path.Root("requests_per_second"), "Invalid Requests Per Second",
+				fmt.Sprintf("requests_per_second must be zero or greater, got %g.", v))
+		}
+		opts.RequestsPerSecond = v
+	}
+	if v, ok := int64Setting(config.RateLimitBurst, "rate_limit_burst", "WARPSTREAM_RATE_LIMIT_BURST", diags); ok {
+		if v < 0 {
+			diags.AddAttributeError(path.Root("rate_limit_burst"), "Invalid Rate Limit Burst",
+				fmt.Sprintf("rate_limit_burst must be zero or greater, got %d.", v))
+		}
+		opts.RateLimitBurst = int(v)
+	}
+	if v, ok := int64Setting(config.MaxConcurrentRequests, "max_concurrent_requests", "WARPSTREAM_MAX_CONCURRENT_REQUESTS", diags); ok {
+		if v < 0 {
+			diags.AddAttributeError(path.Root("max_concurrent_requests"), "Invalid Max Concurrent Requests",
+				fmt.Sprintf("max_concurrent_requests must be zero or greater, got %d.", v))
+		}
+		opts.MaxInFlight = int(v)
+	}
+
+	if opts.RetryWaitMin > opts.RetryWaitMax {
+		diags.AddAttributeError(path.Root("min_retry_backoff"), "Invalid Retry Backoff",
+			fmt.Sprintf("min_retry_backoff (%s) must not be greater than max_retry_backoff (%s).", opts.RetryWaitMin, opts.RetryWaitMax))
+	}
+
+	transportOptions(config, &opts, diags)
+
+	return opts
+}
+
+// int64Setting returns the configured value of an integer provider attribute, falling back to its
+// environment variable. ok is false when neither is set.
+func int64Setting(value types.Int64, attr, envVar string, diags *diag.Diagnostics) (int64, bool) {
+	if !value.IsNull() && !value.IsUnknown() {
+		return value.ValueInt64(), true
+	}
+
+	raw := os.Getenv(envVar)
+	if raw == "" {
+		return 0, false
+	}
+
+	v, err := strconv.ParseInt(raw, 10, 64)
+	if err != nil {
+		diags.AddAttributeError(path.Root(attr), "Invalid Environment Variable",
+			fmt.Sprintf("%s must be an integer, got %q.", envVar, raw))
+		return 0, false
+	}
+	return v, true
+}
+
+// float64Setting returns the configured value of a number provider attribute, falling back to its
+// environment variable. ok is false when neither is set.
+func float64Setting(value types.Float64, attr, envVar string, diags *diag.Diagnostics) (float64, bool) {
+	if !value.IsNull() && !value.IsUnknown() {
+		return value.ValueFloat64(), true
+	}
+
+	raw := os.Getenv(envVar)
+	if raw == "" {
+		return 0, false
+	}
+
+	v, err := strconv.ParseFloat(raw, 64)
+	if err != nil {
+		diags.AddAttributeError(path.Root(attr), "Invalid Environment Variable",
+			fmt.Sprintf("%s must be a number, got %q.", envVar, raw))
+		return 0, false
+	}
+	return v, true
+}
+
+// durationSetting returns the configured value of a duration provider attribute such as "30s",
+// falling back to its environment variable. ok is false when neither is set.
+func durationSetting(value types.String, attr, envVar string, diags *diag.Diagnostics) (time.Duration, bool) {
+	source := attr
+	raw := os.Getenv(envVar)
+	if raw != "" {
+		source = envVar
+	}
+	if !value.IsNull() && !value.IsUnknown() {
+		raw = value.ValueString()
+		source = attr
+	}
+	if raw == "" {
+		return 0, false
+	}
+
+	d, err := time.ParseDuration(raw)
+	if err != nil {
+		diags.AddAttributeError(path.Root(attr), "Invalid Duration",
+			fmt.Sprintf("%s must be a duration such as \"30s\" or \"2m\", got %q.", source, raw))
+		return 0, false
+	}
+	if d <= 0 {
+		diags.AddAttributeError(path.Root(attr), "Invalid Duration",
+			fmt.Sprintf("%s must be greater than zero, got %q.", source, raw))
+		return 0, false
+	}
+	return d, true
+}
@@ -0,0 +1,226 @@
+package provider
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/warpstreamlabs/terraform-provider-warpstream/internal/provider/api"
+)
+
+func TestClientOptionsDefaults(t *testing.T) {
+	var diags diag.Diagnostics
+	opts := clientOptions(warpstreamProviderModel{}, &diags)
+
+	require.False(t, diags.HasError(), diags)
+	require.Equal(t, api.DefaultClientOptions(), opts)
+}
+
+func TestClientOptionsConfigOverridesEnv(t *testing.T) {
+	t.Setenv("WARPSTREAM_MAX_RETRIES", "2")
+	t.Setenv("WARPSTREAM_REQUEST_TIMEOUT", "10s")
+	t.Setenv("WARPSTREAM_CALL_TIMEOUT", "1m")
+	t.Setenv("WARPSTREAM_REQUESTS_PER_SECOND", "2.5")
+	t.Setenv("WARPSTREAM_MAX_CONCURRENT_REQUESTS", "4")
+
+	var diags diag.Diagnostics
+	opts := clientOptions(warpstreamProviderModel{
+		MaxRetries:      types.Int64Value(8),
+		MinRetryBackoff: types.StringValue("500ms"),
+		CallTimeout:     types.StringValue("10m"),
+
+		MaxConcurrentRequests: types.Int64Value(16),
+	}, &diags)
+
+	require.False(t, diags.HasError(), diags)
+	require.Equal(t, 8, opts.MaxRetries)
+	require.Equal(t, 500*time.Millisecond, opts.RetryWaitMin)
+	require.Equal(t, 30*time.Second, opts.RetryWaitMax)
+	require.Equal(t, 10*time.Second, opts.RequestTimeout)
+	require.Equal(t, 10*time.Minute, opts.CallTimeout)
+	require.Equal(t, 2.5, opts.RequestsPerSecond)
+	require.Equal(t, 16, opts.MaxInFlight)
+}
+
+func TestClientOptionsInvalid(t *testing.T) {
+	tests := []struct {
+		name   string
+		env    map[string]string
+		config warpstreamProviderModel
+	}{
+		{
+			name:   "unparsable duration",
+			config: warpstreamProviderModel{RequestTimeout: types.StringValue("thirty seconds")},
+		},
+		{
+			name:   "non-positive duration",
+			config: warpstreamProviderModel{CallTimeout: types.StringValue("0s")},
+		},
+		{
+			name: "unparsable env var",
+			env:  map[string]string{"WARPSTREAM_MAX_RETRIES": "many"},
+		},
+		{
+			name:   "negative retries",
+			config: warpstreamProviderModel{MaxRetries: types.Int64Value(-1)},
+		},
+		{
+			name:   "negative concurrency limit",
+			config: warpstreamProviderModel{MaxConcurrentRequests: types.Int64Value(-4)},
+		},
+		{
+			name: "unparsable rate env var",
+			env:  map[string]string{"WARPSTREAM_REQUESTS_PER_SECOND": "fast"},
+		},
+		{
+			name: "min backoff above max backoff",
+			config: warpstreamProviderModel{
+				MinRetryBackoff: types.StringValue("1m"),
+				MaxRetryBackoff: types.StringValue("10s"),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for k, v := range tt.env {
+				t.Setenv(k, v)
+			}
+
+			var diags diag.Diagnostics
+			clientOptions(tt.config, &diags)
+
+			require.True(t, diags.HasError())
+		})
+	}
+}
+
+func TestClientOptionsTransport(t *testing.T) {
+	certPEM, keyPEM := testCertificate(t)
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	require.NoError(t, os.WriteFile(caFile, certPEM, 0o600))
+	t.Setenv("WARPSTREAM_PROXY_URL", "http://env-proxy.example.com:3128")
+
+	var diags diag.Diagnostics
+	opts := clientOptions(warpstreamProviderModel{
+		CACertFile: types.StringValue(caFile),
+		ProxyURL:   types.StringValue("http://proxy.example.com:3128"),
+		ClientCert: types.StringValue(string(certPEM)),
+		ClientKey:  types.StringValue(string(keyPEM)),
+	}, &diags)
+
+	require.False(t, diags.HasError(), diags)
+	require.Equal(t, "proxy.example.com:3128", opts.ProxyURL.Host)
+	require.NotNil(t, opts.TLSConfig)
+	require.NotNil(t, opts.TLSConfig.RootCAs)
+	require.Len(t, opts.TLSConfig.Certificates, 1)
+	require.False(t, opts.TLSConfig.InsecureSkipVerify)
+}
+
+func TestClientOptionsInsecureSkipVerifyWarns(t *testing.T) {
+	var diags diag.Diagnostics
+	opts := clientOptions(warpstreamProviderModel{InsecureSkipVerify: types.BoolValue(true)}, &diags)
+
+	require.False(t, diags.HasError(), diags)
+	require.Equal(t, 1, diags.WarningsCount())
+	require.True(t, opts.TLSConfig.InsecureSkipVerify)
+}
+
+func TestClientOptionsInvalidTransport(t *testing.T) {
+	certPEM, keyPEM := testCertificate(t)
+
+	tests := []struct {
+		name   string
+		env    map[string]string
+		config warpstreamProviderModel
+	}{
+		{
+			name:   "proxy without host",
+			config: warpstreamProviderModel{ProxyURL: types.StringValue("proxy.example.com")},
+		},
+		{
+			name:   "unsupported proxy scheme",
+			config: warpstreamProviderModel{ProxyURL: types.StringValue("ftp://proxy.example.com")},
+		},
+		{
+			name:   "CA without certificates",
+			config: warpstreamProviderModel{CACertPEM: types.StringValue("not a certificate")},
+		},
+		{
+			name:   "missing CA file",
+			config: warpstreamProviderModel{CACertFile: types.StringValue(filepath.Join(t.TempDir(), "missing.pem"))},
+		},
+		{
+			name: "CA PEM and file",
+			env:  map[string]string{"WARPSTREAM_CA_CERT_FILE": "/etc/ssl/ca.pem"},
+			config: warpstreamProviderModel{
+				CACertPEM: types.StringValue(string(certPEM)),
+			},
+		},
+		{
+			name:   "client certificate without key",
+			config: warpstreamProviderModel{ClientCert: types.StringValue(string(certPEM))},
+		},
+		{
+			name: "mismatched client key",
+			config: warpstreamProviderModel{
+				ClientCert: types.StringValue(string(certPEM)),
+				ClientKey:  types.StringValue(string(keyPEM[:len(keyPEM)/2])),
+			},
+		},
+		{
+			name: "unparsable insecure env var",
+			env:  map[string]string{"WARPSTREAM_INSECURE_SKIP_VERIFY": "sometimes"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for k, v := range tt.env {
+				t.Setenv(k, v)
+			}
+
+			var diags diag.Diagnostics
+			clientOptions(tt.config, &diags)
+
+			require.True(t, diags.HasError())
+		})
+	}
+}
+
+// testCertificate returns a self-signed certificate and its private key, PEM-encoded.
+func testCertificate(t *testing.T) ([]byte, []byte) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "terraform-provider-warpstream test"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+}
@@ -0,0 +1,128 @@
+package provider
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/url"
+	"os"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/warpstreamlabs/terraform-provider-warpstream/internal/provider/api"
+)
+
+// transportOptions adds the CA bundle, proxy and client certificate settings to opts. Like the
+// other client options, each setting falls back to its environment variable.
+func transportOptions(config warpstreamProviderModel, opts *api.ClientOptions, diags *diag.Diagnostics) {
+	if raw, source, ok := stringSetting(config.ProxyURL, "proxy_url", "WARPSTREAM_PROXY_URL"); ok {
+		u, err := url.Parse(raw)
+		if err != nil || u.Host == "" || (u.Scheme != "http" && u.Scheme != "https" && u.Scheme != "socks5") {
+			diags.AddAttributeError(path.Root("proxy_url"), "Invalid Proxy URL",
+				fmt.Sprintf("%s must be an http, https or socks5 URL such as \"http://proxy.example.com:3128\", got %q.", source, raw))
+		} else {
+			opts.ProxyURL = u
+		}
+	}
+
+	var (
+		tlsConfig = &tls.Config{MinVersion: tls.VersionTLS12}
+		customTLS bool
+	)
+
+	caPEM, caPEMSource, hasCAPEM := stringSetting(config.CACertPEM, "ca_cert_pem", "WARPSTREAM_CA_CERT_PEM")
+	caFile, caFileSource, hasCAFile := stringSetting(config.CACertFile, "ca_cert_file", "WARPSTREAM_CA_CERT_FILE")
+	switch {
+	case hasCAPEM && hasCAFile:
+		diags.AddAttributeError(path.Root("ca_cert_pem"), "Conflicting CA Certificates",
+			fmt.Sprintf("Only one of %s and %s may be set.", caPEMSource, caFileSource))
+	case hasCAFile:
+		data, err := os.ReadFile(caFile)
+		if err != nil {
+			diags.AddAttributeError(path.Root("ca_cert_file"), "Unable to Read CA Certificate",
+				fmt.Sprintf("Reading %s from %s: %s.", caFileSource, caFile, err))
+			break
+		}
+		caPEM, caPEMSource, hasCAPEM = string(data), caFileSource, true
+	}
+	if hasCAPEM {
+		// The CA is added to the system roots rather than replacing them, so that a proxy which
+		// only inspects some traffic keeps working for the rest.
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM([]byte(caPEM)) {
+			diags.AddAttributeError(path.Root("ca_cert_pem"), "Invalid CA Certificate",
+				fmt.Sprintf("%s does not contain any PEM-encoded certificates.", caPEMSource))
+		}
+		tlsConfig.RootCAs = pool
+		customTLS = true
+	}
+
+	cert, certSource, hasCert := stringSetting(config.ClientCert, "client_cert", "WARPSTREAM_CLIENT_CERT")
+	key, keySource, hasKey := stringSetting(config.ClientKey, "client_key", "WARPSTREAM_CLIENT_KEY")
+	switch {
+	case hasCert != hasKey:
+		diags.AddAttributeError(path.Root("client_cert"), "Incomplete Client Certificate",
+			"client_cert and client_key must be set together.")
+	case hasCert:
+		pair, err := tls.X509KeyPair([]byte(cert), []byte(key))
+		if err != nil {
+			diags.AddAttributeError(path.Root("client_cert"), "Invalid Client Certificate",
+				fmt.Sprintf("Loading the key pair from %s and %s: %s.", certSource, keySource, err))
+			break
+		}
+		tlsConfig.Certificates = []tls.Certificate{pair}
+		customTLS = true
+	}
+
+	if insecure, ok := boolSetting(config.InsecureSkipVerify, "insecure_skip_verify", "WARPSTREAM_INSECURE_SKIP_VERIFY", diags); ok && insecure {
+		diags.AddAttributeWarning(path.Root("insecure_skip_verify"), "TLS Verification Disabled",
+			"insecure_skip_verify is set, so the provider does not check the WarpStream API's certificate. "+
+				"Anyone able to intercept the connection can read and change API traffic, including the API key. "+
+				"Prefer ca_cert_pem or ca_cert_file to trust a TLS-inspecting proxy.")
+		tlsConfig.InsecureSkipVerify = true
+		customTLS = true
+	}
+
+	if customTLS {
+		opts.TLSConfig = tlsConfig
+	}
+}
+
+// stringSetting returns the configured value of a string provider attribute, falling back to its
+// environment variable, along with where the value came from. ok is false when neither is set.
+func stringSetting(value types.String, attr, envVar string) (string, string, bool) {
+	if !value.IsNull() && !value.IsUnknown() && value.ValueString() != "" {
+		return value.ValueString(), attr, true
+	}
+	if raw := os.Getenv(envVar); raw != "" {
+		return raw, envVar, true
+	}
+	return "", "", false
+}
+
+// boolSetting returns the configured value of a boolean provider attribute, falling back to its
+// environment variable. ok is false when neither is set.
+func boolSetting(value types.Bool, attr, envVar string, diags *diag.Diagnostics) (bool, bool) {
+	if !value.IsNull() && !value.IsUnknown() {
+		return value.ValueBool(), true
+	}
+
+	raw := os.Getenv(envVar)
+	if raw == "" {
+		return false, false
+	}
+
+	v, err := strconv.ParseBool(raw)
+	if err != nil {
+		diags.AddAttributeError(path.Root(attr), "Invalid Environment Variable",
+			fmt.Sprintf("%s must be true or false, got %q.", envVar, raw))
+		return false, false
+	}
+	return v, true
+}
@@ -53,7 +53,7 @@ The WarpStream provider must be authenticated with an application key to read th
 // Read refreshes the Terraform state with the latest data.
 func (d *agentKeysDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var state agentKeysDataSourceModel
-	apiKeys, err := d.client.GetAPIKeys()
+	apiKeys, err := d.client.GetAPIKeys(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to Read WarpStream Agent Keys", err.Error())
 		return
@@ -107,5 +107,10 @@ func (d *agentKeysDataSource) Configure(_ context.Context, req datasource.Config
 		return
 	}
 
+	if err := client.RequireKeyKind("warpstream_agent_keys", api.KeyKindApplication, api.KeyKindAccount); err != nil {
+		resp.Diagnostics.AddError("Unsupported WarpStream API Key", err.Error())
+		return
+	}
+
 	d.client = client
 }
@@ -77,7 +77,7 @@ If the WarpStream provider is authenticated with an account key, it lists applic
 // Read refreshes the Terraform state with the latest data.
 func (d *applicationKeysDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var state applicationKeysDataSourceModel
-	apiKeys, err := d.client.GetAPIKeys()
+	apiKeys, err := d.client.GetAPIKeys(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to Read WarpStream Application Keys", err.Error())
 		return
@@ -129,5 +129,10 @@ func (d *applicationKeysDataSource) Configure(_ context.Context, req datasource.
 		return
 	}
 
+	if err := client.RequireKeyKind("warpstream_application_keys", api.KeyKindApplication, api.KeyKindAccount); err != nil {
+		resp.Diagnostics.AddError("Unsupported WarpStream API Key", err.Error())
+		return
+	}
+
 	d.client = client
 }
@@ -103,7 +103,7 @@ func (d *clientMetricsSubscriptionsDataSource) Read(ctx context.Context, req dat
 
 	vcID := config.VirtualClusterID.ValueString()
 
-	subs, err := d.client.ListClientMetricsSubscriptions(vcID)
+	subs, err := d.client.ListClientMetricsSubscriptions(ctx, vcID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to List WarpStream Client Metrics Subscriptions",
@@ -153,5 +153,10 @@ func (d *clientMetricsSubscriptionsDataSource) Configure(_ context.Context, req
 		return
 	}
 
+	if err := client.RequireKeyKind("warpstream_client_metrics_subscriptions", api.KeyKindApplication, api.KeyKindAccount); err != nil {
+		resp.Diagnostics.AddError("Unsupported WarpStream API Key", err.Error())
+		return
+	}
+
 	d.client = client
 }
@@ -108,9 +108,9 @@ func (d *schemaRegistryDataSource) Read(ctx context.Context, req datasource.Read
 	var err error
 
 	if data.Name.ValueString() != "" {
-		vc, err = d.client.FindVirtualCluster(data.Name.ValueString())
+		vc, err = d.client.FindVirtualCluster(ctx, data.Name.ValueString())
 	} else {
-		vc, err = d.client.GetVirtualCluster(data.ID.ValueString())
+		vc, err = d.client.GetVirtualCluster(ctx, data.ID.ValueString())
 	}
 
 	if err != nil {
@@ -174,5 +174,10 @@ func (d *schemaRegistryDataSource) Configure(_ context.Context, req datasource.C
 		return
 	}
 
+	if err := client.RequireKeyKind("warpstream_schema_registry", api.KeyKindApplication, api.KeyKindAccount); err != nil {
+		resp.Diagnostics.AddError("Unsupported WarpStream API Key", err.Error())
+		return
+	}
+
 	d.client = client
 }
@@ -74,7 +74,7 @@ func (d *ssoConfigurationDataSource) Read(ctx context.Context, req datasource.Re
 		return
 	}
 
-	ssoConfig, err := d.client.GetSSOConfigurationWithoutID()
+	ssoConfig, err := d.client.GetSSOConfigurationWithoutID(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to Read WarpStream SSO configuration", err.Error())
 		return
@@ -117,5 +117,10 @@ func (d *ssoConfigurationDataSource) Configure(_ context.Context, req datasource
 		return
 	}
 
+	if err := client.RequireKeyKind("warpstream_sso_configuration", api.KeyKindAccount); err != nil {
+		resp.Diagnostics.AddError("Unsupported WarpStream API Key", err.Error())
+		return
+	}
+
 	d.client = client
 }
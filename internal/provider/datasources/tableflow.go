@@ -104,9 +104,9 @@ func (d *tableFlowDataSource) Read(ctx context.Context, req datasource.ReadReque
 	var err error
 
 	if data.Name.ValueString() != "" {
-		vc, err = d.client.FindVirtualCluster(data.Name.ValueString())
+		vc, err = d.client.FindVirtualCluster(ctx, data.Name.ValueString())
 	} else {
-		vc, err = d.client.GetVirtualCluster(data.ID.ValueString())
+		vc, err = d.client.GetVirtualCluster(ctx, data.ID.ValueString())
 	}
 
 	if err != nil {
@@ -166,5 +166,10 @@ func (d *tableFlowDataSource) Configure(_ context.Context, req datasource.Config
 		return
 	}
 
+	if err := client.RequireKeyKind("warpstream_tableflow_cluster", api.KeyKindApplication, api.KeyKindAccount); err != nil {
+		resp.Diagnostics.AddError("Unsupported WarpStream API Key", err.Error())
+		return
+	}
+
 	d.client = client
 }
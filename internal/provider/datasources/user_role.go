@@ -117,9 +117,9 @@ func (d *userRoleDataSource) Read(ctx context.Context, req datasource.ReadReques
 	)
 
 	if roleID != "" {
-		role, err = d.client.GetUserRole(roleID)
+		role, err = d.client.GetUserRole(ctx, roleID)
 	} else {
-		role, err = d.client.FindUserRole(roleName)
+		role, err = d.client.FindUserRole(ctx, roleName)
 	}
 
 	if err != nil {
@@ -164,5 +164,10 @@ func (d *userRoleDataSource) Configure(_ context.Context, req datasource.Configu
 		return
 	}
 
+	if err := client.RequireKeyKind("warpstream_user_role", api.KeyKindAccount); err != nil {
+		resp.Diagnostics.AddError("Unsupported WarpStream API Key", err.Error())
+		return
+	}
+
 	d.client = client
 }
@@ -225,11 +225,11 @@ func (d *virtualClusterDataSource) Read(ctx context.Context, req datasource.Read
 	var err error
 
 	if data.Default.ValueBool() {
-		vc, err = d.client.GetDefaultCluster()
+		vc, err = d.client.GetDefaultCluster(ctx)
 	} else if data.Name.ValueString() != "" {
-		vc, err = d.client.FindVirtualCluster(data.Name.ValueString())
+		vc, err = d.client.FindVirtualCluster(ctx, data.Name.ValueString())
 	} else {
-		vc, err = d.client.GetVirtualCluster(data.ID.ValueString())
+		vc, err = d.client.GetVirtualCluster(ctx, data.ID.ValueString())
 	}
 
 	if err != nil {
@@ -268,7 +268,7 @@ func (d *virtualClusterDataSource) Read(ctx context.Context, req datasource.Read
 	}
 
 	// Read virtual cluster configuration
-	cfg, err := d.client.GetConfiguration(*vc)
+	cfg, err := d.client.GetConfiguration(ctx, *vc)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Read configuration of Virtual Cluster with ID="+vc.ID,
@@ -305,7 +305,7 @@ func (d *virtualClusterDataSource) Read(ctx context.Context, req datasource.Read
 	state.Tier = types.StringValue(cfg.Tier)
 
 	// Read virtual cluster events state
-	eventsState, err := d.client.GetEventsState(*vc)
+	eventsState, err := d.client.GetEventsState(ctx, *vc)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Read events state of Virtual Cluster with ID="+vc.ID,
@@ -356,7 +356,7 @@ func (d *virtualClusterDataSource) Read(ctx context.Context, req datasource.Read
 		return
 	}
 
-	tags, err := d.client.GetTags(*vc)
+	tags, err := d.client.GetTags(ctx, *vc)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Read tags of Virtual Cluster with ID="+vc.ID,
@@ -443,5 +443,10 @@ func (d *virtualClusterDataSource) Configure(_ context.Context, req datasource.C
 		return
 	}
 
+	if err := client.RequireKeyKind("warpstream_virtual_cluster", api.KeyKindApplication, api.KeyKindAccount); err != nil {
+		resp.Diagnostics.AddError("Unsupported WarpStream API Key", err.Error())
+		return
+	}
+
 	d.client = client
 }
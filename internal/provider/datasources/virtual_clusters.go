@@ -105,7 +105,7 @@ The WarpStream provider must be authenticated with an application key to read th
 // Read refreshes the Terraform state with the latest data.
 func (d *virtualClustersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var state virtualClustersDataSourceModel
-	virtualClusters, err := d.client.GetVirtualClusters()
+	virtualClusters, err := d.client.GetVirtualClusters(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Read WarpStream Virtual Clusters",
@@ -160,5 +160,10 @@ func (d *virtualClustersDataSource) Configure(_ context.Context, req datasource.
 		return
 	}
 
+	if err := client.RequireKeyKind("warpstream_virtual_clusters", api.KeyKindApplication, api.KeyKindAccount); err != nil {
+		resp.Diagnostics.AddError("Unsupported WarpStream API Key", err.Error())
+		return
+	}
+
 	d.client = client
 }
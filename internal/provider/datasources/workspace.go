@@ -70,7 +70,7 @@ func (d *workspaceDataSource) Read(ctx context.Context, req datasource.ReadReque
 	}
 
 	workspaceID := data.ID.ValueString()
-	workspace, err := d.client.GetWorkspace(workspaceID)
+	workspace, err := d.client.GetWorkspace(ctx, workspaceID)
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to Read WarpStream Workspace", err.Error())
 		return
@@ -79,7 +79,7 @@ func (d *workspaceDataSource) Read(ctx context.Context, req datasource.ReadReque
 	data.Name = types.StringValue(workspace.Name)
 	data.CreatedAt = types.StringValue(workspace.CreatedAt)
 
-	apiKeys, err := d.client.GetAPIKeys()
+	apiKeys, err := d.client.GetAPIKeys(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to Read WarpStream Application Keys", err.Error())
 		return
@@ -127,5 +127,10 @@ func (d *workspaceDataSource) Configure(_ context.Context, req datasource.Config
 		return
 	}
 
+	if err := client.RequireKeyKind("warpstream_workspace", api.KeyKindAccount); err != nil {
+		resp.Diagnostics.AddError("Unsupported WarpStream API Key", err.Error())
+		return
+	}
+
 	d.client = client
 }
@@ -0,0 +1,24 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// deferUnknownConfig defers every resource and data source of the provider when part of its
+// configuration, such as a token or base_url taken from a resource created in the same apply, is
+// not known yet and Terraform supports deferred actions. It reports whether it deferred.
+//
+// Without deferred actions, Configure falls back to rejecting the unknown value, or to an empty
+// token for an unknown token.
+func deferUnknownConfig(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) bool {
+	if req.Config.Raw.IsFullyKnown() || !req.ClientCapabilities.DeferralAllowed {
+		return false
+	}
+
+	tflog.Info(ctx, "Deferring WarpStream resources and data sources until the provider configuration is known")
+	resp.Deferred = &provider.Deferred{Reason: provider.DeferredReasonProviderConfigUnknown}
+	return true
+}
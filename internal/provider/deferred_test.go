@@ -0,0 +1,64 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/require"
+)
+
+// configureWith runs Configure with every attribute null except those in values.
+func configureWith(t *testing.T, deferralAllowed bool, values map[string]tftypes.Value) provider.ConfigureResponse {
+	t.Helper()
+
+	p := New("test")()
+	var schemaResp provider.SchemaResponse
+	p.Schema(t.Context(), provider.SchemaRequest{}, &schemaResp)
+	require.False(t, schemaResp.Diagnostics.HasError())
+
+	typ := schemaResp.Schema.Type().TerraformType(t.Context()).(tftypes.Object)
+	attrs := make(map[string]tftypes.Value, len(typ.AttributeTypes))
+	for name, attrType := range typ.AttributeTypes {
+		attrs[name] = tftypes.NewValue(attrType, nil)
+	}
+	for name, value := range values {
+		attrs[name] = value
+	}
+
+	req := provider.ConfigureRequest{
+		Config:             tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(typ, attrs)},
+		ClientCapabilities: provider.ConfigureProviderClientCapabilities{DeferralAllowed: deferralAllowed},
+	}
+	var resp provider.ConfigureResponse
+	p.Configure(t.Context(), req, &resp)
+	return resp
+}
+
+func TestConfigureDefersUnknownConfig(t *testing.T) {
+	unknown := tftypes.NewValue(tftypes.String, tftypes.UnknownValue)
+
+	for _, attr := range []string{"token", "base_url"} {
+		t.Run(attr, func(t *testing.T) {
+			resp := configureWith(t, true, map[string]tftypes.Value{attr: unknown})
+			require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+			require.NotNil(t, resp.Deferred)
+			require.Equal(t, provider.DeferredReasonProviderConfigUnknown, resp.Deferred.Reason)
+			require.Nil(t, resp.ResourceData)
+		})
+	}
+}
+
+func TestConfigureUnknownConfigWithoutDeferral(t *testing.T) {
+	unknown := tftypes.NewValue(tftypes.String, tftypes.UnknownValue)
+
+	resp := configureWith(t, false, map[string]tftypes.Value{"base_url": unknown})
+	require.True(t, resp.Diagnostics.HasError())
+	require.Nil(t, resp.Deferred)
+
+	resp = configureWith(t, false, map[string]tftypes.Value{"token": unknown})
+	require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+	require.Nil(t, resp.Deferred)
+	require.NotNil(t, resp.ResourceData)
+}
@@ -0,0 +1,35 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/warpstreamlabs/terraform-provider-warpstream/internal/provider/api"
+)
+
+// identifyKey records the kind of the provider's API key on the client. An invalid key fails
+// Configure; other errors only warn.
+func identifyKey(ctx context.Context, client *api.Client, diags *diag.Diagnostics) {
+	identity, err := client.Identify(ctx)
+	if api.IsStatus(err, http.StatusUnauthorized) {
+		diags.AddAttributeError(path.Root("token"), "Invalid WarpStream API Key",
+			"The WarpStream API rejected the provider's API key: "+err.Error())
+		return
+	}
+	if err != nil {
+		diags.AddWarning("Unable to Identify WarpStream API Key",
+			"The provider could not tell which kind of API key it is configured with, "+
+				"so resources that need another kind will only fail when they call the API: "+err.Error())
+		return
+	}
+
+	tflog.Info(ctx, "Identified WarpStream API key", map[string]any{
+		"key_kind":     string(identity.Kind),
+		"workspace_id": identity.WorkspaceID,
+	})
+	client.Identity = identity
+}
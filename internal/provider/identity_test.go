@@ -0,0 +1,26 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/stretchr/testify/require"
+
+	"github.com/warpstreamlabs/terraform-provider-warpstream/internal/provider/api"
+	"github.com/warpstreamlabs/terraform-provider-warpstream/internal/provider/apitest/fakeserver"
+)
+
+func TestIdentifyKeyRejectsInvalidKey(t *testing.T) {
+	server := fakeserver.New()
+	defer server.Close()
+
+	token := ""
+	client, err := api.NewClient(server.URL(), &token, "test", api.DefaultClientOptions())
+	require.NoError(t, err)
+
+	var diags diag.Diagnostics
+	identifyKey(t.Context(), client, &diags)
+
+	require.True(t, diags.HasError())
+	require.Equal(t, "Invalid WarpStream API Key", diags.Errors()[0].Summary())
+}
@@ -13,3 +13,20 @@ type ACL struct {
 	ResourceName     types.String `tfsdk:"resource_name"`
 	PatternType      types.String `tfsdk:"pattern_type"`
 }
+
+type ACLEntry struct {
+	Host           types.String `tfsdk:"host"`
+	Principal      types.String `tfsdk:"principal"`
+	Operation      types.String `tfsdk:"operation"`
+	PermissionType types.String `tfsdk:"permission_type"`
+	ResourceType   types.String `tfsdk:"resource_type"`
+	ResourceName   types.String `tfsdk:"resource_name"`
+	PatternType    types.String `tfsdk:"pattern_type"`
+}
+
+type ACLs struct {
+	ID               types.String `tfsdk:"id"`
+	VirtualClusterID types.String `tfsdk:"virtual_cluster_id"`
+	Exclusive        types.Bool   `tfsdk:"exclusive"`
+	ACLs             []ACLEntry   `tfsdk:"acls"`
+}
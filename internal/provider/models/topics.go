@@ -15,3 +15,15 @@ type Topic struct {
 	DeletionProtectionEnabled types.Bool    `tfsdk:"enable_deletion_protection"`
 	Config                    []TopicConfig `tfsdk:"config"`
 }
+
+type TopicsEntry struct {
+	PartitionCount types.Int64   `tfsdk:"partition_count"`
+	Config         []TopicConfig `tfsdk:"config"`
+}
+
+type Topics struct {
+	ID               types.String           `tfsdk:"id"`
+	VirtualClusterID types.String           `tfsdk:"virtual_cluster_id"`
+	Config           []TopicConfig          `tfsdk:"config"`
+	Topics           map[string]TopicsEntry `tfsdk:"topics"`
+}
@@ -36,6 +36,7 @@ type VirtualClusterResource struct {
 	CreatedAt     types.String `tfsdk:"created_at"`
 	Default       types.Bool   `tfsdk:"default"`
 	Tags          types.Map    `tfsdk:"tags"`
+	TagsAll       types.Map    `tfsdk:"tags_all"`
 	Configuration types.Object `tfsdk:"configuration"`
 	// BrokerConfiguration is a generic map of Kafka-style cluster config, disjoint from
 	// Configuration.
@@ -0,0 +1,131 @@
+package provider
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// credentialsFile is where named profiles live, relative to the user's home directory.
+var credentialsFile = filepath.Join(".config", "warpstream", "credentials")
+
+// credentialsProfile is one section of the credentials file. Every field is optional; the provider
+// falls back to the environment for anything a profile leaves out.
+type credentialsProfile struct {
+	Token     string
+	BaseURL   string
+	Workspace string
+}
+
+// loadProfile reads the named section of ~/.config/warpstream/credentials.
+func loadProfile(name string) (credentialsProfile, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return credentialsProfile{}, fmt.Errorf("locating the credentials file: %w", err)
+	}
+	file := filepath.Join(home, credentialsFile)
+
+	f, err := os.Open(file)
+	if err != nil {
+		return credentialsProfile{}, fmt.Errorf("reading the credentials file: %w", err)
+	}
+	defer f.Close()
+
+	profile, err := parseProfile(f, name)
+	if err != nil {
+		return credentialsProfile{}, fmt.Errorf("%s: %w", file, err)
+	}
+	return profile, nil
+}
+
+// parseProfile reads the named section from an INI-style credentials file:
+//
+//	[staging]
+//	token     = aks_...
+//	base_url  = https://api.prod.us-east-1.warpstream.com/api/v1
+//	workspace = wi_...
+//
+// Blank lines and lines starting with # or ; are ignored, as are keys the provider doesn't use, so
+// the file can be shared with other tools.
+func parseProfile(r io.Reader, name string) (credentialsProfile, error) {
+	var (
+		profile credentialsProfile
+		section string
+		found   bool
+		lineNum int
+	)
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		lineNum++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";") {
+			continue
+		}
+
+		if strings.HasPrefix(line, "[") {
+			if !strings.HasSuffix(line, "]") {
+				return credentialsProfile{}, fmt.Errorf("line %d: unterminated section header %q", lineNum, line)
+			}
+			section = strings.TrimSpace(line[1 : len(line)-1])
+			if section == name {
+				found = true
+			}
+			continue
+		}
+
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			return credentialsProfile{}, fmt.Errorf("line %d: expected key = value, got %q", lineNum, line)
+		}
+		if section != name {
+			continue
+		}
+		value = strings.Trim(strings.TrimSpace(value), `"`)
+		switch strings.TrimSpace(key) {
+		case "token":
+			profile.Token = value
+		case "base_url":
+			profile.BaseURL = value
+		case "workspace":
+			profile.Workspace = value
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return credentialsProfile{}, err
+	}
+
+	if !found {
+		return credentialsProfile{}, fmt.Errorf("no profile named %q", name)
+	}
+	return profile, nil
+}
+
+// selectedProfile loads the profile named by the profile attribute or, failing that, the
+// WARPSTREAM_PROFILE environment variable. It returns an empty profile when neither is set.
+func selectedProfile(config warpstreamProviderModel, diags *diag.Diagnostics) credentialsProfile {
+	if config.Profile.IsUnknown() {
+		diags.AddAttributeError(path.Root("profile"), "Unknown WarpStream Profile",
+			"The provider cannot create the WarpStream API client as there is an unknown configuration value for the profile. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the WARPSTREAM_PROFILE environment variable.")
+		return credentialsProfile{}
+	}
+
+	name, source, ok := stringSetting(config.Profile, "profile", "WARPSTREAM_PROFILE")
+	if !ok {
+		return credentialsProfile{}
+	}
+
+	profile, err := loadProfile(name)
+	if err != nil {
+		diags.AddAttributeError(path.Root("profile"), "Unable to Load WarpStream Profile",
+			fmt.Sprintf("Loading the profile %q named by %s: %s.", name, source, err))
+	}
+	return profile
+}
@@ -0,0 +1,91 @@
+package provider
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/require"
+)
+
+const testCredentials = `
+# Shared with other tools.
+[dev]
+token = aks_dev
+base_url = "https://api.dev.example.com/api/v1"
+region = us-east-1
+
+; No workspace for staging.
+[staging]
+token=aks_staging
+
+[prod]
+token     = aks_prod
+workspace = wi_prod
+`
+
+func TestParseProfile(t *testing.T) {
+	tests := []struct {
+		name string
+		want credentialsProfile
+	}{
+		{name: "dev", want: credentialsProfile{Token: "aks_dev", BaseURL: "https://api.dev.example.com/api/v1"}},
+		{name: "staging", want: credentialsProfile{Token: "aks_staging"}},
+		{name: "prod", want: credentialsProfile{Token: "aks_prod", Workspace: "wi_prod"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			profile, err := parseProfile(strings.NewReader(testCredentials), tt.name)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, profile)
+		})
+	}
+}
+
+func TestParseProfileErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		file    string
+		wantErr string
+	}{
+		{name: "missing profile", file: testCredentials, wantErr: `no profile named "missing"`},
+		{name: "unterminated section", file: "[missing\ntoken = aks_x\n", wantErr: "line 1: unterminated section header"},
+		{name: "line without value", file: "[missing]\ntoken\n", wantErr: "line 2: expected key = value"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := parseProfile(strings.NewReader(tt.file), "missing")
+			require.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestSelectedProfile(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv("USERPROFILE", home)
+	file := filepath.Join(home, credentialsFile)
+	require.NoError(t, os.MkdirAll(filepath.Dir(file), 0o700))
+	require.NoError(t, os.WriteFile(file, []byte(testCredentials), 0o600))
+
+	t.Setenv("WARPSTREAM_PROFILE", "dev")
+
+	var diags diag.Diagnostics
+	profile := selectedProfile(warpstreamProviderModel{}, &diags)
+	require.False(t, diags.HasError(), diags)
+	require.Equal(t, "aks_dev", profile.Token)
+
+	// The attribute beats the environment variable.
+	profile = selectedProfile(warpstreamProviderModel{Profile: types.StringValue("prod")}, &diags)
+	require.False(t, diags.HasError(), diags)
+	require.Equal(t, "wi_prod", profile.Workspace)
+
+	selectedProfile(warpstreamProviderModel{Profile: types.StringValue("missing")}, &diags)
+	require.True(t, diags.HasError())
+	require.Contains(t, diags.Errors()[0].Detail(), `no profile named "missing"`)
+}
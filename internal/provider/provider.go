@@ -2,18 +2,26 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"net/http"
 	"os"
+	"strings"
+	"sync"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/warpstreamlabs/terraform-provider-warpstream/internal/provider/api"
 	"github.com/warpstreamlabs/terraform-provider-warpstream/internal/provider/datasources"
 	"github.com/warpstreamlabs/terraform-provider-warpstream/internal/provider/resources"
+	"github.com/warpstreamlabs/terraform-provider-warpstream/internal/provider/utils"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
@@ -22,11 +30,26 @@ var (
 )
 
 // New is a helper function to simplify provider server and testing implementation.
-func New(version string) func() provider.Provider {
+func New(version string, opts ...Option) func() provider.Provider {
 	return func() provider.Provider {
-		return &warpstreamProvider{
+		p := &warpstreamProvider{
 			version: version,
 		}
+		for _, opt := range opts {
+			opt(p)
+		}
+		return p
+	}
+}
+
+// Option customizes a provider built by New.
+type Option func(*warpstreamProvider)
+
+// WithTransport wraps the transport the provider's API client sends requests through. Acceptance
+// tests use it to record and replay API traffic.
+func WithTransport(wrap func(http.RoundTripper) http.RoundTripper) Option {
+	return func(p *warpstreamProvider) {
+		p.wrapTransport = wrap
 	}
 }
 
@@ -36,12 +59,46 @@ type warpstreamProvider struct {
 	// provider is built and ran locally, and "test" when running acceptance
 	// testing.
 	version string
+	// wrapTransport is passed to the API client as ClientOptions.WrapTransport.
+	wrapTransport func(http.RoundTripper) http.RoundTripper
+
+	// tokenCommandCache holds the output of each token command that has run, keyed by command.
+	tokenCommandMu    sync.Mutex
+	tokenCommandCache map[string]string
 }
 
 // warpstreamProviderModel describes the provider data model.
 type warpstreamProviderModel struct {
-	Token   types.String `tfsdk:"token"`
-	BaseUrl types.String `tfsdk:"base_url"`
+	Token           types.String `tfsdk:"token"`
+	TokenFile       types.String `tfsdk:"token_file"`
+	TokenCommand    types.String `tfsdk:"token_command"`
+	Profile         types.String `tfsdk:"profile"`
+	BaseUrl         types.String `tfsdk:"base_url"`
+	Region          types.String `tfsdk:"region"`
+	MaxRetries      types.Int64  `tfsdk:"max_retries"`
+	MinRetryBackoff types.String `tfsdk:"min_retry_backoff"`
+	MaxRetryBackoff types.String `tfsdk:"max_retry_backoff"`
+	RequestTimeout  types.String `tfsdk:"request_timeout"`
+	CallTimeout     types.String `tfsdk:"call_timeout"`
+
+	RequestsPerSecond     types.Float64 `tfsdk:"requests_per_second"`
+	RateLimitBurst        types.Int64   `tfsdk:"rate_limit_burst"`
+	MaxConcurrentRequests types.Int64   `tfsdk:"max_concurrent_requests"`
+
+	CACertPEM          types.String `tfsdk:"ca_cert_pem"`
+	CACertFile         types.String `tfsdk:"ca_cert_file"`
+	ProxyURL           types.String `tfsdk:"proxy_url"`
+	InsecureSkipVerify types.Bool   `tfsdk:"insecure_skip_verify"`
+	ClientCert         types.String `tfsdk:"client_cert"`
+	ClientKey          types.String `tfsdk:"client_key"`
+
+	DefaultTags types.Object `tfsdk:"default_tags"`
+	IgnoreTags  types.Object `tfsdk:"ignore_tags"`
+
+	DefaultVirtualClusterID types.String `tfsdk:"default_virtual_cluster_id"`
+	ReadOnly                types.Bool   `tfsdk:"read_only"`
+	AllowedWorkspaceIDs     types.Set    `tfsdk:"allowed_workspace_ids"`
+	ForbiddenWorkspaceIDs   types.Set    `tfsdk:"forbidden_workspace_ids"`
 }
 
 // Metadata returns the provider type name.
@@ -54,14 +111,183 @@ func (p *warpstreamProvider) Metadata(_ context.Context, _ provider.MetadataRequ
 func (p *warpstreamProvider) Schema(_ context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"allowed_workspace_ids": schema.SetAttribute{
+				Description: "IDs of the workspaces the provider may manage. The provider fails to configure if its API key " +
+					"can't reach any of them, and resources in other workspaces fail to plan. Conflicts with forbidden_workspace_ids.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(utils.StartsWith("wi_")),
+					setvalidator.ConflictsWith(path.MatchRoot("forbidden_workspace_ids")),
+				},
+			},
+			"forbidden_workspace_ids": schema.SetAttribute{
+				Description: "IDs of workspaces the provider must not manage. The provider fails to configure if its API key " +
+					"can only reach these workspaces, and resources in them fail to plan. Conflicts with allowed_workspace_ids.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(utils.StartsWith("wi_")),
+				},
+			},
 			"base_url": schema.StringAttribute{
 				Description: "Base URL for WarpStream API. May also be provided via WARPSTREAM_API_URL environment variable.",
 				Optional:    true,
 			},
+			"region": schema.StringAttribute{
+				Description: "Control plane region to manage, which picks the base URL for WarpStream API. " +
+					"Clusters managed by the provider must be in this region: the cloud.region of warpstream_virtual_cluster, " +
+					"warpstream_schema_registry and warpstream_tableflow_cluster resources is checked against it at plan time. " +
+					"Conflicts with base_url, and with a different URL set by the profile or the WARPSTREAM_API_URL environment variable. " +
+					"Valid regions are: " + strings.Join(api.ControlPlaneRegions(), ", ") + ".",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(api.ControlPlaneRegions()...),
+					stringvalidator.ConflictsWith(path.MatchRoot("base_url")),
+				},
+			},
+			"default_virtual_cluster_id": schema.StringAttribute{
+				Description: "Virtual Cluster ID that cluster-scoped resources, such as topics and ACLs, use when they leave out virtual_cluster_id. " +
+					"Changing it replaces the resources that use it. May also be provided via WARPSTREAM_DEFAULT_VIRTUAL_CLUSTER_ID environment variable.",
+				Optional:   true,
+				Validators: []validator.String{utils.StartsWithAndAlphanumeric("vci_")},
+			},
+			"read_only": schema.BoolAttribute{
+				Description: "Refuse every API call that would change something, such as for scheduled drift detection. " +
+					"Plans that change resources warn, and applying them fails before any request is sent. " +
+					"May also be provided via WARPSTREAM_READ_ONLY environment variable.",
+				Optional: true,
+			},
 			"token": schema.StringAttribute{
 				Description: "Token for WarpStream API. May also be provided via WARPSTREAM_API_KEY environment variable.",
 				Optional:    true,
 				Sensitive:   true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("token_file"), path.MatchRoot("token_command")),
+				},
+			},
+			"token_file": schema.StringAttribute{
+				Description: "Path to a file holding the token for WarpStream API, such as one written by Vault Agent. " +
+					"The file is read each time the provider is configured. Conflicts with token and token_command. " +
+					"May also be provided via WARPSTREAM_API_KEY_FILE environment variable.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("token_command")),
+				},
+			},
+			"token_command": schema.StringAttribute{
+				Description: "Shell command that prints the token for WarpStream API on standard output. " +
+					"It runs once per provider process and its output is cached. Conflicts with token and token_file. " +
+					"May also be provided via WARPSTREAM_API_KEY_COMMAND environment variable.",
+				Optional: true,
+			},
+			"profile": schema.StringAttribute{
+				Description: "Name of a profile in ~/.config/warpstream/credentials to take the token, base_url and workspace from. " +
+					"Explicitly configured token and base_url attributes take precedence over the profile, and the profile takes " +
+					"precedence over the WARPSTREAM_API_KEY and WARPSTREAM_API_URL environment variables. " +
+					"May also be provided via WARPSTREAM_PROFILE environment variable.",
+				Optional: true,
+			},
+			"max_retries": schema.Int64Attribute{
+				Description: "Maximum number of times a failed API request is retried. Defaults to 5. " +
+					"May also be provided via WARPSTREAM_MAX_RETRIES environment variable.",
+				Optional: true,
+			},
+			"min_retry_backoff": schema.StringAttribute{
+				Description: "Minimum wait between retries of a failed API request, as a duration such as \"1s\". Defaults to 1s. " +
+					"May also be provided via WARPSTREAM_MIN_RETRY_BACKOFF environment variable.",
+				Optional: true,
+			},
+			"max_retry_backoff": schema.StringAttribute{
+				Description: "Maximum wait between retries of a failed API request, as a duration such as \"30s\". Defaults to 30s. " +
+					"May also be provided via WARPSTREAM_MAX_RETRY_BACKOFF environment variable.",
+				Optional: true,
+			},
+			"request_timeout": schema.StringAttribute{
+				Description: "Timeout for a single API request attempt, as a duration such as \"30s\". Defaults to 30s. " +
+					"May also be provided via WARPSTREAM_REQUEST_TIMEOUT environment variable.",
+				Optional: true,
+			},
+			"call_timeout": schema.StringAttribute{
+				Description: "Overall deadline for an API call, retries included, as a duration such as \"4m\". Defaults to 4m. " +
+					"May also be provided via WARPSTREAM_CALL_TIMEOUT environment variable.",
+				Optional: true,
+			},
+			"requests_per_second": schema.Float64Attribute{
+				Description: "Maximum steady rate of API requests, retries included. Unlimited by default. " +
+					"May also be provided via WARPSTREAM_REQUESTS_PER_SECOND environment variable.",
+				Optional: true,
+			},
+			"rate_limit_burst": schema.Int64Attribute{
+				Description: "Number of API requests that may be sent at once above requests_per_second. Defaults to requests_per_second. " +
+					"May also be provided via WARPSTREAM_RATE_LIMIT_BURST environment variable.",
+				Optional: true,
+			},
+			"max_concurrent_requests": schema.Int64Attribute{
+				Description: "Maximum number of API requests awaiting a response at any time. Unlimited by default. " +
+					"May also be provided via WARPSTREAM_MAX_CONCURRENT_REQUESTS environment variable.",
+				Optional: true,
+			},
+			"ca_cert_pem": schema.StringAttribute{
+				Description: "PEM-encoded CA certificates to trust in addition to the system roots, e.g. for a TLS-inspecting proxy. " +
+					"Conflicts with ca_cert_file. May also be provided via WARPSTREAM_CA_CERT_PEM environment variable.",
+				Optional: true,
+			},
+			"ca_cert_file": schema.StringAttribute{
+				Description: "Path to a file of PEM-encoded CA certificates to trust in addition to the system roots. " +
+					"Conflicts with ca_cert_pem. May also be provided via WARPSTREAM_CA_CERT_FILE environment variable.",
+				Optional: true,
+			},
+			"proxy_url": schema.StringAttribute{
+				Description: "URL of an http, https or socks5 proxy to send API requests through. Defaults to the proxy named by " +
+					"the HTTPS_PROXY and NO_PROXY environment variables. May also be provided via WARPSTREAM_PROXY_URL environment variable.",
+				Optional: true,
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				Description: "Skip verifying the WarpStream API's TLS certificate. Insecure, and only meant for debugging; " +
+					"prefer ca_cert_pem or ca_cert_file. May also be provided via WARPSTREAM_INSECURE_SKIP_VERIFY environment variable.",
+				Optional: true,
+			},
+			"client_cert": schema.StringAttribute{
+				Description: "PEM-encoded client certificate to present for mutual TLS. Requires client_key. " +
+					"May also be provided via WARPSTREAM_CLIENT_CERT environment variable.",
+				Optional: true,
+			},
+			"client_key": schema.StringAttribute{
+				Description: "PEM-encoded private key of client_cert. " +
+					"May also be provided via WARPSTREAM_CLIENT_KEY environment variable.",
+				Optional:  true,
+				Sensitive: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"default_tags": schema.SingleNestedBlock{
+				Description: "Tags applied to every virtual cluster the provider manages. " +
+					"A virtual cluster's own tags override default tags with the same key.",
+				Attributes: map[string]schema.Attribute{
+					"tags": schema.MapAttribute{
+						Description: "Tags to apply to every virtual cluster.",
+						Optional:    true,
+						ElementType: types.StringType,
+					},
+				},
+			},
+			"ignore_tags": schema.SingleNestedBlock{
+				Description: "Tags that systems outside Terraform manage on virtual clusters. " +
+					"They are not reported as drift and are left in place when the provider updates a virtual cluster's tags, " +
+					"unless the virtual cluster's own tags set them.",
+				Attributes: map[string]schema.Attribute{
+					"keys": schema.SetAttribute{
+						Description: "Tag keys to ignore.",
+						Optional:    true,
+						ElementType: types.StringType,
+					},
+					"key_prefixes": schema.SetAttribute{
+						Description: "Tag key prefixes to ignore.",
+						Optional:    true,
+						ElementType: types.StringType,
+					},
+				},
 			},
 		},
 	}
@@ -77,6 +303,10 @@ func (p *warpstreamProvider) Configure(ctx context.Context, req provider.Configu
 		return
 	}
 
+	if deferUnknownConfig(ctx, req, resp) {
+		return
+	}
+
 	// If practitioner provided a configuration value for the base URL, it must be a known value.
 
 	if config.BaseUrl.IsUnknown() {
@@ -88,22 +318,74 @@ func (p *warpstreamProvider) Configure(ctx context.Context, req provider.Configu
 		)
 	}
 
+	if config.Region.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("region"),
+			"Unknown Warpstream Region",
+			"The provider cannot create the WarpStream API client as there is an unknown configuration value for the Warpstream region. "+
+				"Either target apply the source of the value first, or set the value statically in the configuration.",
+		)
+	}
+
+	if config.DefaultVirtualClusterID.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("default_virtual_cluster_id"),
+			"Unknown Default Virtual Cluster ID",
+			"The provider cannot default virtual_cluster_id as there is an unknown configuration value for default_virtual_cluster_id. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the WARPSTREAM_DEFAULT_VIRTUAL_CLUSTER_ID environment variable.",
+		)
+	}
+
+	if config.ReadOnly.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("read_only"),
+			"Unknown Read-Only Setting",
+			"The provider cannot tell whether it may make changes as there is an unknown configuration value for read_only. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the WARPSTREAM_READ_ONLY environment variable.",
+		)
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	// Default values to environment variables, but override
-	// with Terraform configuration value if set.
+	// Default values to environment variables, override them with the
+	// selected profile, and override both with Terraform configuration
+	// values if set.
 
-	token := os.Getenv("WARPSTREAM_API_KEY")
-	host := os.Getenv("WARPSTREAM_API_URL")
+	profile := selectedProfile(config, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
+	host, hostSource := os.Getenv("WARPSTREAM_API_URL"), "the WARPSTREAM_API_URL environment variable"
+	if profile.BaseURL != "" {
+		host, hostSource = profile.BaseURL, "the profile's base_url"
+	}
 	if !config.BaseUrl.IsNull() {
-		host = config.BaseUrl.ValueString()
+		host, hostSource = config.BaseUrl.ValueString(), "base_url"
+	}
+	if !config.Region.IsNull() {
+		regionHost, ok := api.RegionHostURL(config.Region.ValueString())
+		if !ok {
+			resp.Diagnostics.AddAttributeError(path.Root("region"), "Invalid Warpstream Region",
+				fmt.Sprintf("There is no WarpStream control plane in %q. Valid regions are: %s.",
+					config.Region.ValueString(), strings.Join(api.ControlPlaneRegions(), ", ")))
+			return
+		}
+		if host != "" && strings.TrimSuffix(host, "/") != regionHost {
+			resp.Diagnostics.AddAttributeError(path.Root("region"), "Conflicting Warpstream API URLs",
+				fmt.Sprintf("The region %q selects the WarpStream API at %s, but %s sets it to %s. "+
+					"Unset one of them, or make them agree.",
+					config.Region.ValueString(), regionHost, hostSource, host))
+			return
+		}
+		host = regionHost
 	}
 
-	if !config.Token.IsNull() {
-		token = config.Token.ValueString()
+	token := p.resolveToken(ctx, config, profile, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
 	if token == "" {
@@ -116,8 +398,14 @@ func (p *warpstreamProvider) Configure(ctx context.Context, req provider.Configu
 		)
 	}
 
+	opts := clientOptions(config, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	opts.WrapTransport = p.wrapTransport
+
 	// Create a new WarpStream client using the configuration values
-	client, err := api.NewClient(host, &token, p.version)
+	client, err := api.NewClient(host, &token, p.version, opts)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Create Warpstream API Client",
@@ -127,11 +415,30 @@ func (p *warpstreamProvider) Configure(ctx context.Context, req provider.Configu
 		)
 		return
 	}
+	client.WorkspaceID = profile.Workspace
+	client.Region = config.Region.ValueString()
+	client.DefaultTags = defaultTags(ctx, config, &resp.Diagnostics)
+	client.IgnoreTags = ignoreTags(ctx, config, &resp.Diagnostics)
+	client.DefaultVirtualClusterID, _, _ = stringSetting(config.DefaultVirtualClusterID, "default_virtual_cluster_id", "WARPSTREAM_DEFAULT_VIRTUAL_CLUSTER_ID")
+	client.ReadOnly, _ = boolSetting(config.ReadOnly, "read_only", "WARPSTREAM_READ_ONLY", &resp.Diagnostics)
+	client.AllowedWorkspaceIDs = workspaceIDs(ctx, config.AllowedWorkspaceIDs, "allowed_workspace_ids", &resp.Diagnostics)
+	client.ForbiddenWorkspaceIDs = workspaceIDs(ctx, config.ForbiddenWorkspaceIDs, "forbidden_workspace_ids", &resp.Diagnostics)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	if token != "" {
+		identifyKey(ctx, client, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		checkWorkspaces(ctx, client, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	// Make the Warpstream client available during DataSource and Resource
 	// type Configure methods.
 	resp.DataSourceData = client
@@ -167,8 +474,10 @@ func (p *warpstreamProvider) Resources(_ context.Context) []func() resource.Reso
 		resources.NewSchemaRegistryResource,
 		resources.NewTableFlowResource,
 		resources.NewTopicResource,
+		resources.NewTopicsResource,
 		resources.NewWorkspaceResource,
 		resources.NewACLResource,
+		resources.NewACLsResource,
 		resources.NewSSOConfigurationResource,
 		resources.NewClientMetricsSubscriptionResource,
 		resources.NewWorkloadIdentityFederationResource,
@@ -0,0 +1,30 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/require"
+)
+
+func hasErrorSummary(resp provider.ConfigureResponse, summary string) bool {
+	for _, d := range resp.Diagnostics.Errors() {
+		if d.Summary() == summary {
+			return true
+		}
+	}
+	return false
+}
+
+func TestConfigureRegionConflictsWithAPIURL(t *testing.T) {
+	region := map[string]tftypes.Value{"region": tftypes.NewValue(tftypes.String, "eu-west-1")}
+
+	t.Setenv("WARPSTREAM_API_URL", "https://api.prod.us-east-1.warpstream.com/api/v1")
+	resp := configureWith(t, false, region)
+	require.True(t, hasErrorSummary(resp, "Conflicting Warpstream API URLs"), "%v", resp.Diagnostics)
+
+	t.Setenv("WARPSTREAM_API_URL", "https://api.prod.eu-west-1.warpstream.com/api/v1/")
+	resp = configureWith(t, false, region)
+	require.False(t, hasErrorSummary(resp, "Conflicting Warpstream API URLs"), "%v", resp.Diagnostics)
+}
@@ -32,6 +32,7 @@ var (
 	_ resource.Resource                = &aclResource{}
 	_ resource.ResourceWithConfigure   = &aclResource{}
 	_ resource.ResourceWithImportState = &aclResource{}
+	_ resource.ResourceWithModifyPlan  = &aclResource{}
 )
 
 func NewACLResource() resource.Resource {
@@ -59,6 +60,11 @@ func (a *aclResource) Configure(ctx context.Context, req resource.ConfigureReque
 		return
 	}
 
+	if err := client.RequireKeyKind("warpstream_acl", api.KeyKindApplication, api.KeyKindAccount); err != nil {
+		resp.Diagnostics.AddError("Unsupported WarpStream API Key", err.Error())
+		return
+	}
+
 	a.client = client
 }
 
@@ -67,6 +73,13 @@ func (a *aclResource) Metadata(_ context.Context, req resource.MetadataRequest,
 	resp.TypeName = req.ProviderTypeName + "_acl"
 }
 
+func (a *aclResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	defer warnReadOnly(a.client, req, resp)
+
+	planVirtualClusterID(ctx, a.client, req, resp)
+	checkPlannedClusterWorkspace(ctx, a.client, req, resp)
+}
+
 func (a *aclResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: `
@@ -83,10 +96,12 @@ The WarpStream provider must be authenticated with an application key to consume
 				},
 			},
 			"virtual_cluster_id": schema.StringAttribute{
-				Description: "The ID of the Virtual Cluster that the ACL applies to.",
-				Required:    true,
+				Description: "The ID of the Virtual Cluster that the ACL applies to. Defaults to the provider's `default_virtual_cluster_id`.",
+				Optional:    true,
+				Computed:    true,
 				Validators:  []validator.String{utils.ValidClusterID()},
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
@@ -159,7 +174,7 @@ func (a *aclResource) Create(ctx context.Context, req resource.CreateRequest, re
 		PermissionType: plan.PermissionType.ValueString(),
 	}
 
-	existingACL, err := a.client.GetACL(plan.VirtualClusterID.ValueString(), aclToCheck)
+	existingACL, err := a.client.GetACL(ctx, plan.VirtualClusterID.ValueString(), aclToCheck)
 	if err == nil {
 		// ACL already exists - this is a duplicate
 		resp.Diagnostics.AddError(
@@ -180,7 +195,7 @@ func (a *aclResource) Create(ctx context.Context, req resource.CreateRequest, re
 	// If we reach here, ACL doesn't exist (err == ErrNotFound) - proceed with creation
 
 	// Create new ACL
-	acl, err := a.client.CreateACL(plan.VirtualClusterID.ValueString(), api.ACLRequest{
+	acl, err := a.client.CreateACL(ctx, plan.VirtualClusterID.ValueString(), api.ACLRequest{
 		ResourceType:   plan.ResourceType.ValueString(),
 		ResourceName:   plan.ResourceName.ValueString(),
 		PatternType:    plan.PatternType.ValueString(),
@@ -205,7 +220,7 @@ func (a *aclResource) Create(ctx context.Context, req resource.CreateRequest, re
 	}
 
 	// Verify the created ACL
-	acl, err = a.client.GetACL(plan.VirtualClusterID.ValueString(), aclToDescribe)
+	acl, err = a.client.GetACL(ctx, plan.VirtualClusterID.ValueString(), aclToDescribe)
 	if err != nil {
 		resp.Diagnostics.AddError("Error reading ACL after creation", fmt.Sprintf("Failed to read ACL after creation: %s", err.Error()))
 		return
@@ -252,7 +267,7 @@ func (a *aclResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 	}
 
 	// Get the latest ACL data
-	acl, err := a.client.GetACL(state.VirtualClusterID.ValueString(), aclToDescribe)
+	acl, err := a.client.GetACL(ctx, state.VirtualClusterID.ValueString(), aclToDescribe)
 	if err != nil {
 		if errors.Is(err, api.ErrNotFound) {
 			resp.State.RemoveResource(ctx)
@@ -329,7 +344,7 @@ func (a *aclResource) Delete(ctx context.Context, req resource.DeleteRequest, re
 	}
 
 	// Delete the ACL
-	err := a.client.DeleteACL(state.VirtualClusterID.ValueString(), api.ACLRequest{
+	err := a.client.DeleteACL(ctx, state.VirtualClusterID.ValueString(), api.ACLRequest{
 		ResourceType:   state.ResourceType.ValueString(),
 		ResourceName:   state.ResourceName.ValueString(),
 		PatternType:    state.PatternType.ValueString(),
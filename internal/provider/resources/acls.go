@@ -0,0 +1,516 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/warpstreamlabs/terraform-provider-warpstream/internal/provider/api"
+	"github.com/warpstreamlabs/terraform-provider-warpstream/internal/provider/models"
+	"github.com/warpstreamlabs/terraform-provider-warpstream/internal/provider/utils"
+)
+
+// maxPlannedACLChanges is the most ACL changes listed in the plan-time warning.
+const maxPlannedACLChanges = 50
+
+var (
+	_ resource.Resource                = &aclsResource{}
+	_ resource.ResourceWithConfigure   = &aclsResource{}
+	_ resource.ResourceWithModifyPlan  = &aclsResource{}
+	_ resource.ResourceWithImportState = &aclsResource{}
+)
+
+func NewACLsResource() resource.Resource {
+	return &aclsResource{}
+}
+
+// aclsResource manages a set of ACLs of one virtual cluster as a single resource, and in exclusive
+// mode every ACL of the cluster. Each apply lists the cluster's ACLs once, creates the missing ones
+// and deletes the rest in batches.
+type aclsResource struct {
+	client *api.Client
+}
+
+// Configure adds the provider configured client to the resource.
+func (a *aclsResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *api.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	if err := client.RequireKeyKind("warpstream_acls", api.KeyKindApplication, api.KeyKindAccount); err != nil {
+		resp.Diagnostics.AddError("Unsupported WarpStream API Key", err.Error())
+		return
+	}
+
+	a.client = client
+}
+
+// Metadata implements resource.Resource.
+func (a *aclsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_acls"
+}
+
+func (a *aclsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `
+This resource allows you to manage a set of ACLs related to a Virtual Cluster as a single resource.
+
+With ` + "`exclusive`" + ` set, the resource owns every ACL of the Virtual Cluster: ACLs that aren't listed in ` + "`acls`" + `,
+including ones created outside Terraform, are deleted, and destroying the resource deletes every ACL of the Virtual Cluster.
+Don't combine an exclusive ` + "`warpstream_acls`" + ` with ` + "`warpstream_acl`" + ` resources for the same Virtual Cluster.
+
+Without ` + "`exclusive`" + `, ACLs that already exist are not adopted: listing one in ` + "`acls`" + ` is an error.
+To take over existing ACLs, import the resource with the Virtual Cluster ID. That imports every ACL of the Virtual Cluster,
+and ACLs then left out of ` + "`acls`" + ` are deleted by the next apply.
+
+The WarpStream provider must be authenticated with an application key to consume this resource.
+`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the Virtual Cluster that the ACLs apply to.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"virtual_cluster_id": schema.StringAttribute{
+				Description: "The ID of the Virtual Cluster that the ACLs apply to. Defaults to the provider's `default_virtual_cluster_id`.",
+				Optional:    true,
+				Computed:    true,
+				Validators:  []validator.String{utils.ValidClusterID()},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"exclusive": schema.BoolAttribute{
+				Description: "If true, every ACL of the Virtual Cluster that isn't listed in `acls` is deleted. Otherwise only the ACLs removed from `acls` are deleted.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"acls": schema.SetNestedAttribute{
+				Description: "The ACLs.",
+				Required:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"host": schema.StringAttribute{
+							Description: "Host from which the principal will have access. Use * to allow access from any host.",
+							Required:    true,
+						},
+						"principal": schema.StringAttribute{
+							Description: "The principal for the ACL.",
+							Required:    true,
+						},
+						"operation": schema.StringAttribute{
+							Description: "The operation type for the ACL. Accepted values are: `ALL`, `READ`, `WRITE`, `CREATE`, `DELETE`, `ALTER`, `DESCRIBE`, `CLUSTER_ACTION`, `DESCRIBE_CONFIGS`, `ALTER_CONFIGS` or `IDEMPOTENT_WRITE`.",
+							Required:    true,
+							Validators:  []validator.String{stringvalidator.OneOf(validACLOperations...)},
+						},
+						"permission_type": schema.StringAttribute{
+							Description: "The permission for the ACL. Accepted values are: `ALLOW` or `DENY`.",
+							Required:    true,
+							Validators:  []validator.String{stringvalidator.OneOf(validACLPermissionTypes...)},
+						},
+						"resource_type": schema.StringAttribute{
+							Description: "The type of the resource. Accepted values are:  `ANY`, `TOPIC`, `GROUP`, `CLUSTER`, `TRANSACTIONAL_ID` or `DELEGATION_TOKEN`.",
+							Required:    true,
+							Validators:  []validator.String{stringvalidator.OneOf(validACLResourceTypes...)},
+						},
+						"resource_name": schema.StringAttribute{
+							Description: "The resource name for the ACL",
+							Required:    true,
+						},
+						"pattern_type": schema.StringAttribute{
+							Description: "The pattern type for the ACL. Accepted values are `LITERAL` or `PREFIXED`.",
+							Required:    true,
+							Validators:  []validator.String{stringvalidator.OneOf(validACLPatternTypes...)},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (a *aclsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	defer warnReadOnly(a.client, req, resp)
+
+	planVirtualClusterID(ctx, a.client, req, resp)
+	checkPlannedClusterWorkspace(ctx, a.client, req, resp)
+
+	// The changes can only be listed once the virtual cluster and ACLs are known.
+	if a.client == nil || resp.Diagnostics.HasError() || req.Plan.Raw.IsNull() || !resp.Plan.Raw.IsFullyKnown() {
+		return
+	}
+
+	var plan models.ACLs
+	resp.Diagnostics.Append(resp.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var prior []api.ACLRequest
+	if !req.State.Raw.IsNull() {
+		var state models.ACLs
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		prior = aclRequests(state.ACLs)
+	}
+
+	vcID := plan.VirtualClusterID.ValueString()
+	actual, err := a.client.ListACLs(ctx, vcID)
+	if err != nil {
+		// Apply reports the error, if it persists.
+		tflog.Warn(ctx, "Unable to list ACLs to plan warpstream_acls", map[string]any{"virtual_cluster_id": vcID, "error": err.Error()})
+		return
+	}
+
+	desired := aclRequests(plan.ACLs)
+	if !plan.Exclusive.ValueBool() {
+		if unmanaged := unmanagedACLs(actual, desired, prior); len(unmanaged) > 0 {
+			addUnmanagedACLsError(vcID, unmanaged, &resp.Diagnostics)
+			return
+		}
+	}
+
+	create, remove := diffACLs(actual, desired, prior, plan.Exclusive.ValueBool())
+	if len(create) == 0 && len(remove) == 0 {
+		return
+	}
+
+	resp.Diagnostics.AddWarning(
+		"WarpStream ACL Changes",
+		fmt.Sprintf("Applying this plan will create %d and delete %d ACLs in %s:\n\n%s",
+			len(create), len(remove), vcID, formatACLChanges(create, remove)),
+	)
+}
+
+// aclRequests converts the ACLs of the resource to their API form.
+func aclRequests(entries []models.ACLEntry) []api.ACLRequest {
+	acls := make([]api.ACLRequest, 0, len(entries))
+	for _, entry := range entries {
+		acls = append(acls, api.ACLRequest{
+			ResourceType:   entry.ResourceType.ValueString(),
+			ResourceName:   entry.ResourceName.ValueString(),
+			PatternType:    entry.PatternType.ValueString(),
+			Principal:      entry.Principal.ValueString(),
+			Host:           entry.Host.ValueString(),
+			Operation:      entry.Operation.ValueString(),
+			PermissionType: entry.PermissionType.ValueString(),
+		})
+	}
+	return acls
+}
+
+func aclEntry(acl api.ACLResponse) models.ACLEntry {
+	return models.ACLEntry{
+		Host:           types.StringValue(acl.Host),
+		Principal:      types.StringValue(acl.Principal),
+		Operation:      types.StringValue(acl.Operation),
+		PermissionType: types.StringValue(acl.PermissionType),
+		ResourceType:   types.StringValue(acl.ResourceType),
+		ResourceName:   types.StringValue(acl.ResourceName),
+		PatternType:    types.StringValue(acl.PatternType),
+	}
+}
+
+// diffACLs returns the ACLs to create and delete so that the virtual cluster has the desired ACLs.
+// Only ACLs removed since prior are deleted, unless exclusive is set, in which case every ACL that
+// isn't desired is. Both lists are sorted so that plans and applies read the same.
+func diffACLs(actual []api.ACLResponse, desired, prior []api.ACLRequest, exclusive bool) (create, remove []api.ACLRequest) {
+	existing := make(map[string]bool, len(actual))
+	for _, acl := range actual {
+		existing[acl.ID()] = true
+	}
+	wanted := make(map[string]bool, len(desired))
+	for _, acl := range desired {
+		wanted[acl.ID()] = true
+		if !existing[acl.ID()] {
+			create = append(create, acl)
+		}
+	}
+
+	if exclusive {
+		for _, acl := range actual {
+			if !wanted[acl.ID()] {
+				remove = append(remove, api.ACLRequest(acl))
+			}
+		}
+	} else {
+		for _, acl := range prior {
+			if !wanted[acl.ID()] && existing[acl.ID()] {
+				remove = append(remove, acl)
+			}
+		}
+	}
+
+	byDescription := func(a, b api.ACLRequest) int { return strings.Compare(formatACL(a), formatACL(b)) }
+	slices.SortFunc(create, byDescription)
+	slices.SortFunc(remove, byDescription)
+	return create, remove
+}
+
+// unmanagedACLs returns the desired ACLs that already exist but aren't in prior. Outside exclusive
+// mode these aren't adopted, since destroying the resource would then delete them.
+func unmanagedACLs(actual []api.ACLResponse, desired, prior []api.ACLRequest) []api.ACLRequest {
+	existing := make(map[string]bool, len(actual))
+	for _, acl := range actual {
+		existing[acl.ID()] = true
+	}
+	managed := make(map[string]bool, len(prior))
+	for _, acl := range prior {
+		managed[acl.ID()] = true
+	}
+
+	var unmanaged []api.ACLRequest
+	for _, acl := range desired {
+		if existing[acl.ID()] && !managed[acl.ID()] {
+			unmanaged = append(unmanaged, acl)
+		}
+	}
+	slices.SortFunc(unmanaged, func(a, b api.ACLRequest) int { return strings.Compare(formatACL(a), formatACL(b)) })
+	return unmanaged
+}
+
+// addUnmanagedACLsError reports ACLs that unmanagedACLs found.
+func addUnmanagedACLsError(vcID string, unmanaged []api.ACLRequest, diags *diag.Diagnostics) {
+	lines := make([]string, 0, len(unmanaged))
+	for _, acl := range unmanaged {
+		lines = append(lines, "  "+formatACL(acl))
+	}
+	diags.AddError("WarpStream ACLs Already Exist",
+		fmt.Sprintf("%d ACLs in %s already exist and are not managed by this resource:\n\n%s\n\n"+
+			"Import the Virtual Cluster's ACLs with `terraform import` to manage them here, or remove them from `acls`.",
+			len(unmanaged), vcID, strings.Join(lines, "\n")))
+}
+
+// formatACL describes an ACL on one line, for example `ALLOW User:alice READ on LITERAL TOPIC
+// "orders" from *`.
+func formatACL(acl api.ACLRequest) string {
+	return fmt.Sprintf("%s %s %s on %s %s %q from %s",
+		acl.PermissionType, acl.Principal, acl.Operation, acl.PatternType, acl.ResourceType, acl.ResourceName, acl.Host)
+}
+
+func formatACLChanges(create, remove []api.ACLRequest) string {
+	var lines []string
+	for _, acl := range create {
+		lines = append(lines, "  + "+formatACL(acl))
+	}
+	for _, acl := range remove {
+		lines = append(lines, "  - "+formatACL(acl))
+	}
+	if len(lines) > maxPlannedACLChanges {
+		more := len(lines) - maxPlannedACLChanges
+		lines = append(lines[:maxPlannedACLChanges], fmt.Sprintf("  ... and %d more", more))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// applyACLs creates the desired ACLs that don't exist yet and deletes the ones diffACLs picks,
+// diffing them against a single list of the virtual cluster's ACLs. New ACLs are created before
+// any are deleted, so that access isn't briefly lost when an ACL is replaced by a broader one.
+//
+// It returns the ACLs the resource manages afterwards, for readACLs: those it applied, and removed
+// ones that may have failed to delete. Outside exclusive mode, desired ACLs that already exist but
+// aren't in prior fail rather than being adopted, and are left out.
+func (a *aclsResource) applyACLs(ctx context.Context, vcID string, desired, prior []api.ACLRequest, exclusive bool, diags *diag.Diagnostics) []api.ACLRequest {
+	actual, err := a.client.ListACLs(ctx, vcID)
+	if errors.Is(err, api.ErrNotFound) && len(desired) == 0 {
+		// The virtual cluster is gone, and its ACLs with it.
+		return nil
+	}
+	if err != nil {
+		diags.AddError("Error reading ACLs", fmt.Sprintf("Failed to list ACLs of %s: %s", vcID, err.Error()))
+		return slices.Clone(prior)
+	}
+
+	skipped := make(map[string]bool)
+	if !exclusive {
+		unmanaged := unmanagedACLs(actual, desired, prior)
+		if len(unmanaged) > 0 {
+			addUnmanagedACLsError(vcID, unmanaged, diags)
+		}
+		for _, acl := range unmanaged {
+			skipped[acl.ID()] = true
+		}
+	}
+
+	create, remove := diffACLs(actual, desired, prior, exclusive)
+	for _, acl := range create {
+		if _, err := a.client.CreateACL(ctx, vcID, acl); err != nil {
+			diags.AddError("Error creating ACL", fmt.Sprintf("Failed to create ACL %s: %s", formatACL(acl), err.Error()))
+			skipped[acl.ID()] = true
+		}
+	}
+
+	managed := slices.DeleteFunc(slices.Clone(desired), func(acl api.ACLRequest) bool { return skipped[acl.ID()] })
+	if len(remove) == 0 {
+		return managed
+	}
+	if _, err := a.client.DeleteACLs(ctx, vcID, remove); err != nil {
+		diags.AddError("Error deleting ACLs", fmt.Sprintf("Failed to delete ACLs of %s: %s", vcID, err.Error()))
+		managed = append(managed, remove...)
+	}
+	return managed
+}
+
+// readACLs returns the state of the given ACLs from a single list of the virtual cluster's ACLs,
+// leaving out the ones that no longer exist. In exclusive mode it returns every ACL of the cluster,
+// so that ACLs created outside Terraform show up in the plan.
+func (a *aclsResource) readACLs(ctx context.Context, vcID string, entries []api.ACLRequest, exclusive bool) ([]models.ACLEntry, error) {
+	actual, err := a.client.ListACLs(ctx, vcID)
+	if err != nil {
+		return nil, err
+	}
+
+	managed := make(map[string]bool, len(entries))
+	for _, acl := range entries {
+		managed[acl.ID()] = true
+	}
+
+	state := make([]models.ACLEntry, 0, len(entries))
+	for _, acl := range actual {
+		if exclusive || managed[acl.ID()] {
+			state = append(state, aclEntry(acl))
+		}
+	}
+	return state, nil
+}
+
+func (a *aclsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan models.ACLs
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// On failure, the ACLs that were created are still saved so that they are tracked. Terraform then
+	// taints the resource, and replacing it only deletes the ACLs this resource created. In exclusive
+	// mode the resource owns every ACL anyway, so nothing is saved and the next apply tries again.
+	vcID := plan.VirtualClusterID.ValueString()
+	exclusive := plan.Exclusive.ValueBool()
+	managed := a.applyACLs(ctx, vcID, aclRequests(plan.ACLs), nil, exclusive, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() && (exclusive || len(managed) == 0) {
+		return
+	}
+
+	acls, err := a.readACLs(ctx, vcID, managed, exclusive)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading ACLs after creation", fmt.Sprintf("Failed to read ACLs of %s: %s", vcID, err.Error()))
+		return
+	}
+
+	plan.ID = types.StringValue(vcID)
+	plan.ACLs = acls
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (a *aclsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state models.ACLs
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	acls, err := a.readACLs(ctx, state.VirtualClusterID.ValueString(), aclRequests(state.ACLs), state.Exclusive.ValueBool())
+	if err != nil {
+		if errors.Is(err, api.ErrNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError("Error reading ACLs", fmt.Sprintf("Failed to read ACLs: %s", err.Error()))
+		return
+	}
+
+	state.ACLs = acls
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (a *aclsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state models.ACLs
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	vcID := plan.VirtualClusterID.ValueString()
+	managed := a.applyACLs(ctx, vcID, aclRequests(plan.ACLs), aclRequests(state.ACLs), plan.Exclusive.ValueBool(), &resp.Diagnostics)
+
+	// Read back the ACLs the resource still manages, including any removed ACL that failed to delete
+	// so that the next apply tries again.
+	acls, err := a.readACLs(ctx, vcID, managed, plan.Exclusive.ValueBool())
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading ACLs after update", fmt.Sprintf("Failed to read ACLs of %s: %s", vcID, err.Error()))
+		return
+	}
+
+	plan.ACLs = acls
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete deletes the ACLs in state, which in exclusive mode are every ACL of the virtual cluster.
+func (a *aclsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state models.ACLs
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	a.applyACLs(ctx, state.VirtualClusterID.ValueString(), nil, aclRequests(state.ACLs), false, &resp.Diagnostics)
+}
+
+// ImportState imports every ACL of the virtual cluster whose ID is given, outside exclusive mode.
+// Read then fills them in.
+func (a *aclsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" {
+		resp.Diagnostics.AddError("Invalid Import ID", "Expected the ID of the Virtual Cluster whose ACLs to import")
+		return
+	}
+
+	actual, err := a.client.ListACLs(ctx, req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading ACLs", fmt.Sprintf("Failed to list ACLs of %s: %s", req.ID, err.Error()))
+		return
+	}
+
+	acls := make([]models.ACLEntry, 0, len(actual))
+	for _, acl := range actual {
+		acls = append(acls, aclEntry(acl))
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &models.ACLs{
+		ID:               types.StringValue(req.ID),
+		VirtualClusterID: types.StringValue(req.ID),
+		Exclusive:        types.BoolValue(false),
+		ACLs:             acls,
+	})...)
+}
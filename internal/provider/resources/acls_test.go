@@ -0,0 +1,150 @@
+package resources
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/stretchr/testify/require"
+
+	"github.com/warpstreamlabs/terraform-provider-warpstream/internal/provider/api"
+	"github.com/warpstreamlabs/terraform-provider-warpstream/internal/provider/apitest/fakeserver"
+)
+
+func testACL(resourceName, principal string) api.ACLRequest {
+	return api.ACLRequest{
+		ResourceType:   "TOPIC",
+		ResourceName:   resourceName,
+		PatternType:    "LITERAL",
+		Principal:      principal,
+		Host:           "*",
+		Operation:      "READ",
+		PermissionType: "ALLOW",
+	}
+}
+
+func TestDiffACLs(t *testing.T) {
+	t.Parallel()
+
+	kept := testACL("orders", "User:alice")
+	dropped := testACL("payments", "User:alice")
+	unmanaged := testACL("orders", "User:mallory")
+	added := testACL("refunds", "User:bob")
+	actual := []api.ACLResponse{api.ACLResponse(kept), api.ACLResponse(dropped), api.ACLResponse(unmanaged)}
+	desired := []api.ACLRequest{kept, added}
+	prior := []api.ACLRequest{kept, dropped}
+
+	tests := []struct {
+		name       string
+		exclusive  bool
+		wantCreate []api.ACLRequest
+		wantRemove []api.ACLRequest
+	}{
+		{
+			name:       "only removed ACLs are deleted",
+			wantCreate: []api.ACLRequest{added},
+			wantRemove: []api.ACLRequest{dropped},
+		},
+		{
+			name:       "exclusive deletes unmanaged ACLs",
+			exclusive:  true,
+			wantCreate: []api.ACLRequest{added},
+			wantRemove: []api.ACLRequest{dropped, unmanaged},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			create, remove := diffACLs(actual, desired, prior, tt.exclusive)
+			require.Equal(t, tt.wantCreate, create)
+			require.Equal(t, tt.wantRemove, remove)
+		})
+	}
+}
+
+func TestFormatACLChanges(t *testing.T) {
+	t.Parallel()
+
+	got := formatACLChanges([]api.ACLRequest{testACL("refunds", "User:bob")}, []api.ACLRequest{testACL("orders", "User:mallory")})
+	require.Equal(t, "  + ALLOW User:bob READ on LITERAL TOPIC \"refunds\" from *\n"+
+		"  - ALLOW User:mallory READ on LITERAL TOPIC \"orders\" from *", got)
+
+	var many []api.ACLRequest
+	for i := range maxPlannedACLChanges + 5 {
+		many = append(many, testACL(fmt.Sprintf("topic-%d", i), "User:alice"))
+	}
+	lines := strings.Split(formatACLChanges(many, nil), "\n")
+	require.Len(t, lines, maxPlannedACLChanges+1)
+	require.Equal(t, "  ... and 5 more", lines[maxPlannedACLChanges])
+}
+
+func TestApplyACLsExclusive(t *testing.T) {
+	t.Parallel()
+
+	server := fakeserver.New()
+	t.Cleanup(server.Close)
+
+	token := "aks_test"
+	opts := api.DefaultClientOptions()
+	opts.MaxRetries = 0
+	client, err := api.NewClient(server.URL(), &token, "test", opts)
+	require.NoError(t, err)
+
+	ctx := t.Context()
+	vc, err := client.GetDefaultCluster(ctx)
+	require.NoError(t, err)
+	for _, acl := range []api.ACLRequest{testACL("orders", "User:alice"), testACL("orders", "User:mallory")} {
+		_, err := client.CreateACL(ctx, vc.ID, acl)
+		require.NoError(t, err)
+	}
+
+	r := &aclsResource{client: client}
+	desired := []api.ACLRequest{testACL("orders", "User:alice"), testACL("refunds", "User:bob")}
+
+	var diags diag.Diagnostics
+	r.applyACLs(ctx, vc.ID, desired, nil, true, &diags)
+	require.False(t, diags.HasError(), "%v", diags)
+
+	state, err := r.readACLs(ctx, vc.ID, desired, true)
+	require.NoError(t, err)
+	require.ElementsMatch(t, desired, aclRequests(state))
+}
+
+func TestApplyACLsRejectsExistingACLs(t *testing.T) {
+	t.Parallel()
+
+	server := fakeserver.New()
+	t.Cleanup(server.Close)
+
+	token := "aks_test"
+	opts := api.DefaultClientOptions()
+	opts.MaxRetries = 0
+	client, err := api.NewClient(server.URL(), &token, "test", opts)
+	require.NoError(t, err)
+
+	ctx := t.Context()
+	vc, err := client.GetDefaultCluster(ctx)
+	require.NoError(t, err)
+	existing := testACL("orders", "User:alice")
+	_, err = client.CreateACL(ctx, vc.ID, existing)
+	require.NoError(t, err)
+
+	r := &aclsResource{client: client}
+	added := testACL("refunds", "User:bob")
+
+	var diags diag.Diagnostics
+	managed := r.applyACLs(ctx, vc.ID, []api.ACLRequest{existing, added}, nil, false, &diags)
+	require.True(t, diags.HasError())
+	require.Equal(t, "WarpStream ACLs Already Exist", diags.Errors()[0].Summary())
+	// The new ACL is still created and managed, and the existing one is left out.
+	require.Equal(t, []api.ACLRequest{added}, managed)
+
+	// Once it is in prior state, for example after an import, the existing ACL is managed.
+	diags = nil
+	managed = r.applyACLs(ctx, vc.ID, []api.ACLRequest{existing, added}, []api.ACLRequest{added, existing}, false, &diags)
+	require.False(t, diags.HasError(), "%v", diags)
+	require.Equal(t, []api.ACLRequest{existing, added}, managed)
+}
@@ -19,8 +19,9 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource              = &agentKeyResource{}
-	_ resource.ResourceWithConfigure = &agentKeyResource{}
+	_ resource.Resource               = &agentKeyResource{}
+	_ resource.ResourceWithConfigure  = &agentKeyResource{}
+	_ resource.ResourceWithModifyPlan = &agentKeyResource{}
 )
 
 // NewVirtualClusterResource is a helper function to simplify the provider implementation.
@@ -50,6 +51,11 @@ func (r *agentKeyResource) Configure(_ context.Context, req resource.ConfigureRe
 		return
 	}
 
+	if err := client.RequireKeyKind("warpstream_agent_key", api.KeyKindApplication, api.KeyKindAccount); err != nil {
+		resp.Diagnostics.AddError("Unsupported WarpStream API Key", err.Error())
+		return
+	}
+
 	r.client = client
 }
 
@@ -58,6 +64,12 @@ func (r *agentKeyResource) Metadata(_ context.Context, req resource.MetadataRequ
 	resp.TypeName = req.ProviderTypeName + "_agent_key"
 }
 
+func (r *agentKeyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	defer warnReadOnly(r.client, req, resp)
+
+	checkPlannedClusterWorkspace(ctx, r.client, req, resp)
+}
+
 // Schema defines the schema for the resource.
 func (r *agentKeyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
@@ -138,6 +150,7 @@ func (r *agentKeyResource) Create(ctx context.Context, req resource.CreateReques
 		readOnly = plan.ReadOnly.ValueBool()
 	}
 	apiKey, err := r.client.CreateAgentKey(
+		ctx,
 		plan.Name.ValueString(),
 		plan.VirtualClusterID.ValueString(),
 		readOnly,
@@ -151,7 +164,7 @@ func (r *agentKeyResource) Create(ctx context.Context, req resource.CreateReques
 	}
 
 	// Describe created agent key
-	apiKey, err = r.client.GetAPIKey(apiKey.ID)
+	apiKey, err = r.client.GetAPIKey(ctx, apiKey.ID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading WarpStream Agent Key",
@@ -192,7 +205,7 @@ func (r *agentKeyResource) Read(ctx context.Context, req resource.ReadRequest, r
 		return
 	}
 
-	apiKey, err := r.client.GetAPIKey(state.ID.ValueString())
+	apiKey, err := r.client.GetAPIKey(ctx, state.ID.ValueString())
 	if err != nil {
 		if errors.Is(err, api.ErrNotFound) {
 			resp.State.RemoveResource(ctx)
@@ -245,7 +258,7 @@ func (r *agentKeyResource) Delete(ctx context.Context, req resource.DeleteReques
 	}
 
 	// Delete existing agent key
-	err := r.client.DeleteAPIKey(state.ID.ValueString())
+	err := r.client.DeleteAPIKey(ctx, state.ID.ValueString())
 	if err != nil {
 		if errors.Is(err, api.ErrNotFound) {
 			return
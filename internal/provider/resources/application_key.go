@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
@@ -19,8 +20,9 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource              = &applicationKeyResource{}
-	_ resource.ResourceWithConfigure = &applicationKeyResource{}
+	_ resource.Resource               = &applicationKeyResource{}
+	_ resource.ResourceWithConfigure  = &applicationKeyResource{}
+	_ resource.ResourceWithModifyPlan = &applicationKeyResource{}
 )
 
 // NewApplicationKeyResource is a helper function to simplify the provider implementation.
@@ -50,6 +52,11 @@ func (r *applicationKeyResource) Configure(_ context.Context, req resource.Confi
 		return
 	}
 
+	if err := client.RequireKeyKind("warpstream_application_key", api.KeyKindApplication, api.KeyKindAccount); err != nil {
+		resp.Diagnostics.AddError("Unsupported WarpStream API Key", err.Error())
+		return
+	}
+
 	r.client = client
 }
 
@@ -58,6 +65,13 @@ func (r *applicationKeyResource) Metadata(_ context.Context, req resource.Metada
 	resp.TypeName = req.ProviderTypeName + "_application_key"
 }
 
+func (r *applicationKeyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	defer warnReadOnly(r.client, req, resp)
+
+	checkPlannedWorkspace(ctx, r.client, req, resp)
+	checkDefaultedWorkspace(ctx, r.client, req, resp)
+}
+
 // Schema defines the schema for the resource.
 func (r *applicationKeyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
@@ -95,7 +109,8 @@ If the WarpStream provider is authenticated with an account key, it can access a
 				Description: "Workspace ID. " +
 					"ID of the workspace in which the application key is authorized to manage resources " +
 					"Must be a valid workspace ID starting with 'wi_'. " +
-					"If empty, defaults to the oldest workspace that the provided WarpStream API key is authorized to access. " +
+					"If empty, defaults to the workspace of the provider's profile, if any, and otherwise to the oldest workspace " +
+					"that the provided WarpStream API key is authorized to access. " +
 					"Cannot be changed after creation.",
 				Optional: true,
 				Computed: true,
@@ -144,18 +159,27 @@ func (r *applicationKeyResource) Create(ctx context.Context, req resource.Create
 	if !plan.ReadOnly.IsNull() {
 		readOnly = plan.ReadOnly.ValueBool()
 	}
+	workspaceID := plan.WorkspaceID.ValueString()
+	if workspaceID == "" {
+		workspaceID = r.client.WorkspaceID
+	}
+	// The workspace may not have been known at plan time, so check it again before creating the key.
+	checkWorkspace(ctx, r.client, path.Root("workspace_id"), workspaceID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	apiKey, err := r.client.CreateApplicationKey(
+		ctx,
 		plan.Name.ValueString(),
-		plan.WorkspaceID.ValueString(),
+		workspaceID,
 		readOnly,
 	)
 
-	// TODO: Make client return an structured HTTP error and branch on the specific case where it's the workspace that's not found.
-	if err != nil && errors.Is(err, api.ErrNotFound) {
+	if api.IsWorkspaceNotFound(err) {
 		resp.Diagnostics.AddError(
 			"Error Creating WarpStream Application Key",
 			"Could not create WarpStream Application Key, workspace not found. "+
-				"Either the workspace "+plan.WorkspaceID.ValueString()+" doesn't exist, or the API key used to authenticate "+
+				"Either the workspace "+workspaceID+" doesn't exist, or the API key used to authenticate "+
 				"this provider doesn't have access to it.",
 		)
 		return
@@ -170,7 +194,7 @@ func (r *applicationKeyResource) Create(ctx context.Context, req resource.Create
 	}
 
 	// Describe created application key
-	apiKey, err = r.client.GetAPIKey(apiKey.ID)
+	apiKey, err = r.client.GetAPIKey(ctx, apiKey.ID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading WarpStream Application Key",
@@ -206,7 +230,7 @@ func (r *applicationKeyResource) Read(ctx context.Context, req resource.ReadRequ
 		return
 	}
 
-	apiKey, err := r.client.GetAPIKey(state.ID.ValueString())
+	apiKey, err := r.client.GetAPIKey(ctx, state.ID.ValueString())
 	if err != nil {
 		if errors.Is(err, api.ErrNotFound) {
 			resp.State.RemoveResource(ctx)
@@ -254,7 +278,7 @@ func (r *applicationKeyResource) Delete(ctx context.Context, req resource.Delete
 	}
 
 	// Delete existing application key
-	err := r.client.DeleteAPIKey(state.ID.ValueString())
+	err := r.client.DeleteAPIKey(ctx, state.ID.ValueString())
 	if err != nil {
 		if errors.Is(err, api.ErrNotFound) {
 			return
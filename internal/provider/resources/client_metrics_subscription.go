@@ -27,6 +27,7 @@ var (
 	_ resource.ResourceWithConfigure        = &clientMetricsSubscriptionResource{}
 	_ resource.ResourceWithImportState      = &clientMetricsSubscriptionResource{}
 	_ resource.ResourceWithConfigValidators = &clientMetricsSubscriptionResource{}
+	_ resource.ResourceWithModifyPlan       = &clientMetricsSubscriptionResource{}
 )
 
 func NewClientMetricsSubscriptionResource() resource.Resource {
@@ -51,6 +52,11 @@ func (r *clientMetricsSubscriptionResource) Configure(_ context.Context, req res
 		return
 	}
 
+	if err := client.RequireKeyKind("warpstream_client_metrics_subscription", api.KeyKindApplication, api.KeyKindAccount); err != nil {
+		resp.Diagnostics.AddError("Unsupported WarpStream API Key", err.Error())
+		return
+	}
+
 	r.client = client
 }
 
@@ -58,6 +64,13 @@ func (r *clientMetricsSubscriptionResource) Metadata(_ context.Context, req reso
 	resp.TypeName = req.ProviderTypeName + "_client_metrics_subscription"
 }
 
+func (r *clientMetricsSubscriptionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	defer warnReadOnly(r.client, req, resp)
+
+	planVirtualClusterID(ctx, r.client, req, resp)
+	checkPlannedClusterWorkspace(ctx, r.client, req, resp)
+}
+
 func (r *clientMetricsSubscriptionResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: `
@@ -76,10 +89,12 @@ The WarpStream provider must be authenticated with an application key to consume
 				},
 			},
 			"virtual_cluster_id": schema.StringAttribute{
-				Description: "ID of the Virtual Cluster the subscription belongs to.",
-				Required:    true,
+				Description: "ID of the Virtual Cluster the subscription belongs to. Defaults to the provider's `default_virtual_cluster_id`.",
+				Optional:    true,
+				Computed:    true,
 				Validators:  []validator.String{utils.ValidClusterID()},
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
@@ -142,7 +157,7 @@ func (r *clientMetricsSubscriptionResource) Create(ctx context.Context, req reso
 	vcID := plan.VirtualClusterID.ValueString()
 	name := plan.Name.ValueString()
 
-	if err := r.client.UpdateClientMetricsSubscriptions(vcID, []api.ClientMetricsSubscription{planToSubscription(plan)}); err != nil {
+	if err := r.client.UpdateClientMetricsSubscriptions(ctx, vcID, []api.ClientMetricsSubscription{planToSubscription(plan)}); err != nil {
 		resp.Diagnostics.AddError(
 			"Error Creating WarpStream Client Metrics Subscription",
 			fmt.Sprintf("Could not create subscription %q in virtual cluster %q: %s", name, vcID, err.Error()),
@@ -150,7 +165,7 @@ func (r *clientMetricsSubscriptionResource) Create(ctx context.Context, req reso
 		return
 	}
 
-	sub, err := r.client.DescribeClientMetricsSubscription(vcID, name)
+	sub, err := r.client.DescribeClientMetricsSubscription(ctx, vcID, name)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading WarpStream Client Metrics Subscription After Create",
@@ -173,7 +188,7 @@ func (r *clientMetricsSubscriptionResource) Read(ctx context.Context, req resour
 	vcID := state.VirtualClusterID.ValueString()
 	name := state.Name.ValueString()
 
-	sub, err := r.client.DescribeClientMetricsSubscription(vcID, name)
+	sub, err := r.client.DescribeClientMetricsSubscription(ctx, vcID, name)
 	if err != nil {
 		if errors.Is(err, api.ErrNotFound) {
 			resp.State.RemoveResource(ctx)
@@ -200,7 +215,7 @@ func (r *clientMetricsSubscriptionResource) Update(ctx context.Context, req reso
 	vcID := plan.VirtualClusterID.ValueString()
 	name := plan.Name.ValueString()
 
-	if err := r.client.UpdateClientMetricsSubscriptions(vcID, []api.ClientMetricsSubscription{planToSubscription(plan)}); err != nil {
+	if err := r.client.UpdateClientMetricsSubscriptions(ctx, vcID, []api.ClientMetricsSubscription{planToSubscription(plan)}); err != nil {
 		resp.Diagnostics.AddError(
 			"Error Updating WarpStream Client Metrics Subscription",
 			fmt.Sprintf("Could not update subscription %q in virtual cluster %q: %s", name, vcID, err.Error()),
@@ -208,7 +223,7 @@ func (r *clientMetricsSubscriptionResource) Update(ctx context.Context, req reso
 		return
 	}
 
-	sub, err := r.client.DescribeClientMetricsSubscription(vcID, name)
+	sub, err := r.client.DescribeClientMetricsSubscription(ctx, vcID, name)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading WarpStream Client Metrics Subscription After Update",
@@ -231,7 +246,7 @@ func (r *clientMetricsSubscriptionResource) Delete(ctx context.Context, req reso
 	vcID := state.VirtualClusterID.ValueString()
 	name := state.Name.ValueString()
 
-	if err := r.client.DeleteClientMetricsSubscriptions(vcID, []string{name}); err != nil {
+	if err := r.client.DeleteClientMetricsSubscriptions(ctx, vcID, []string{name}); err != nil {
 		if errors.Is(err, api.ErrNotFound) {
 			return
 		}
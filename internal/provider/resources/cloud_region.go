@@ -0,0 +1,36 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/warpstreamlabs/terraform-provider-warpstream/internal/provider/api"
+)
+
+// validateCloudRegion fails the plan when the provider's `region` attribute is set and the planned
+// `cloud.region` names another region. Setting `region` declares which region the provider manages,
+// so the two are kept consistent. Providers configured with base_url, a profile or the environment
+// instead, and plans without a known region such as ones using `region_group`, are left alone.
+func validateCloudRegion(ctx context.Context, client *api.Client, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if client == nil || client.Region == "" || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var region types.String
+	regionPath := path.Root("cloud").AtName("region")
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, regionPath, &region)...)
+	if resp.Diagnostics.HasError() || region.IsNull() || region.IsUnknown() {
+		return
+	}
+
+	if region.ValueString() != client.Region {
+		resp.Diagnostics.AddAttributeError(regionPath, "Cloud Region Does Not Match Provider Region",
+			fmt.Sprintf("The provider's region attribute is set to %s, so the clusters it manages must be in %s as well, "+
+				"but cloud.region is %s. Set cloud.region to %q, or manage this cluster with a provider whose region is %s.",
+				client.Region, client.Region, region.ValueString(), client.Region, region.ValueString()))
+	}
+}
@@ -0,0 +1,73 @@
+package resources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/require"
+
+	"github.com/warpstreamlabs/terraform-provider-warpstream/internal/provider/api"
+)
+
+// planWithCloudRegion builds a schema registry plan whose attributes are all null except
+// `cloud.region`.
+func planWithCloudRegion(t *testing.T, region *string) tfsdk.Plan {
+	t.Helper()
+
+	ctx := context.Background()
+	var schemaResp resource.SchemaResponse
+	(&schemaRegistryResource{}).Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	require.False(t, schemaResp.Diagnostics.HasError(), "schema: %v", schemaResp.Diagnostics)
+
+	objType, ok := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	require.True(t, ok)
+	cloudType, ok := objType.AttributeTypes["cloud"].(tftypes.Object)
+	require.True(t, ok)
+
+	attrs := make(map[string]tftypes.Value, len(objType.AttributeTypes))
+	for name, ty := range objType.AttributeTypes {
+		attrs[name] = tftypes.NewValue(ty, nil)
+	}
+	var regionValue any
+	if region != nil {
+		regionValue = *region
+	}
+	attrs["cloud"] = tftypes.NewValue(cloudType, map[string]tftypes.Value{
+		"provider": tftypes.NewValue(tftypes.String, "aws"),
+		"region":   tftypes.NewValue(tftypes.String, regionValue),
+	})
+
+	return tfsdk.Plan{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objType, attrs)}
+}
+
+func TestValidateCloudRegion(t *testing.T) {
+	t.Parallel()
+
+	euWest1, usEast1 := "eu-west-1", "us-east-1"
+	tests := []struct {
+		name           string
+		providerRegion string
+		clusterRegion  *string
+		wantErr        bool
+	}{
+		{name: "provider region unset", providerRegion: "", clusterRegion: &usEast1},
+		{name: "regions match", providerRegion: "eu-west-1", clusterRegion: &euWest1},
+		{name: "no cluster region", providerRegion: "eu-west-1", clusterRegion: nil},
+		{name: "regions differ", providerRegion: "eu-west-1", clusterRegion: &usEast1, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			r := &schemaRegistryResource{client: &api.Client{Region: tt.providerRegion}}
+			resp := &resource.ModifyPlanResponse{}
+			r.ModifyPlan(context.Background(), resource.ModifyPlanRequest{Plan: planWithCloudRegion(t, tt.clusterRegion)}, resp)
+
+			require.Equal(t, tt.wantErr, resp.Diagnostics.HasError(), resp.Diagnostics)
+		})
+	}
+}
@@ -27,6 +27,7 @@ var (
 	_ resource.ResourceWithConfigure      = &pipelineResource{}
 	_ resource.ResourceWithImportState    = &pipelineResource{}
 	_ resource.ResourceWithValidateConfig = &pipelineResource{}
+	_ resource.ResourceWithModifyPlan     = &pipelineResource{}
 )
 
 type pipelineType = string
@@ -90,6 +91,11 @@ func (r *pipelineResource) Configure(_ context.Context, req resource.ConfigureRe
 		return
 	}
 
+	if err := client.RequireKeyKind("warpstream_pipeline", api.KeyKindApplication, api.KeyKindAccount); err != nil {
+		resp.Diagnostics.AddError("Unsupported WarpStream API Key", err.Error())
+		return
+	}
+
 	r.client = client
 }
 
@@ -98,6 +104,13 @@ func (r *pipelineResource) Metadata(_ context.Context, req resource.MetadataRequ
 	resp.TypeName = req.ProviderTypeName + "_pipeline"
 }
 
+func (r *pipelineResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	defer warnReadOnly(r.client, req, resp)
+
+	planVirtualClusterID(ctx, r.client, req, resp)
+	checkPlannedClusterWorkspace(ctx, r.client, req, resp)
+}
+
 // Schema defines the schema for the resource.
 func (r *pipelineResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
@@ -109,9 +122,11 @@ The WarpStream provider must be authenticated with an application key to consume
 `,
 		Attributes: map[string]schema.Attribute{
 			"virtual_cluster_id": schema.StringAttribute{
-				Description: "The ID of the virtual cluster associated with the pipeline.",
-				Required:    true,
+				Description: "The ID of the virtual cluster associated with the pipeline. Defaults to the provider's `default_virtual_cluster_id`.",
+				Optional:    true,
+				Computed:    true,
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 				Validators: []validator.String{utils.StartsWithAndAlphanumeric("vci_")},
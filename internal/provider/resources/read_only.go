@@ -0,0 +1,24 @@
+package resources
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	"github.com/warpstreamlabs/terraform-provider-warpstream/internal/provider/api"
+)
+
+// warnReadOnly warns that a planned change cannot be applied while the provider is read-only. It
+// looks at the final plan, so ModifyPlan implementations that do more than this defer it.
+func warnReadOnly(client *api.Client, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if client == nil || !client.ReadOnly {
+		return
+	}
+
+	isCreate, isDestroy := req.State.Raw.IsNull(), resp.Plan.Raw.IsNull()
+	if !isCreate && !isDestroy && len(resp.RequiresReplace) == 0 && resp.Plan.Raw.Equal(req.State.Raw) {
+		return
+	}
+
+	resp.Diagnostics.AddWarning("Provider Is Read-Only",
+		"The provider is configured with read_only, so applying this plan will fail when it tries to change this resource. "+
+			"Unset read_only and WARPSTREAM_READ_ONLY to make changes.")
+}
@@ -0,0 +1,58 @@
+package resources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/require"
+
+	"github.com/warpstreamlabs/terraform-provider-warpstream/internal/provider/api"
+)
+
+func TestWarnReadOnly(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		prior    any // nil for a resource being created
+		planned  any // nil for a resource being destroyed
+		readOnly bool
+		wantWarn bool
+	}{
+		{name: "create", planned: "vci_a", readOnly: true, wantWarn: true},
+		{name: "update", prior: "vci_a", planned: "vci_b", readOnly: true, wantWarn: true},
+		{name: "destroy", prior: "vci_a", readOnly: true, wantWarn: true},
+		{name: "no change", prior: "vci_a", planned: "vci_a", readOnly: true},
+		{name: "not read-only", planned: "vci_a"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			config, schemaResp := withVirtualClusterID(t, tt.planned)
+			null := tftypes.NewValue(config.Type(), nil)
+			req := resource.ModifyPlanRequest{
+				Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: config},
+				Plan:   tfsdk.Plan{Schema: schemaResp.Schema, Raw: config},
+				State:  tfsdk.State{Schema: schemaResp.Schema, Raw: null},
+			}
+			if tt.planned == nil {
+				req.Config.Raw, req.Plan.Raw = null, null
+			}
+			if tt.prior != nil {
+				req.State.Raw, _ = withVirtualClusterID(t, tt.prior)
+			}
+			resp := &resource.ModifyPlanResponse{Plan: req.Plan}
+
+			r := &workloadIdentityFederationResource{client: &api.Client{ReadOnly: tt.readOnly}}
+			r.ModifyPlan(context.Background(), req, resp)
+
+			require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+			require.Equal(t, tt.wantWarn, resp.Diagnostics.WarningsCount() > 0, resp.Diagnostics)
+		})
+	}
+}
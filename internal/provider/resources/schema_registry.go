@@ -29,6 +29,7 @@ var (
 	_ resource.Resource                = &schemaRegistryResource{}
 	_ resource.ResourceWithConfigure   = &schemaRegistryResource{}
 	_ resource.ResourceWithImportState = &schemaRegistryResource{}
+	_ resource.ResourceWithModifyPlan  = &schemaRegistryResource{}
 )
 
 type schemaRegistryResource struct {
@@ -59,6 +60,11 @@ func (r *schemaRegistryResource) Configure(_ context.Context, req resource.Confi
 		return
 	}
 
+	if err := client.RequireKeyKind("warpstream_schema_registry", api.KeyKindApplication, api.KeyKindAccount); err != nil {
+		resp.Diagnostics.AddError("Unsupported WarpStream API Key", err.Error())
+		return
+	}
+
 	r.client = client
 }
 
@@ -93,6 +99,13 @@ var singleRegionCloudSchema = schema.SingleNestedAttribute{
 	},
 }
 
+func (r *schemaRegistryResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	defer warnReadOnly(r.client, req, resp)
+
+	validateCloudRegion(ctx, r.client, req, resp)
+	checkPlannedWorkspace(ctx, r.client, req, resp)
+}
+
 func (r *schemaRegistryResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: `
@@ -170,6 +183,7 @@ func (r *schemaRegistryResource) Create(ctx context.Context, req resource.Create
 
 	// Create new virtual cluster
 	cluster, err := r.client.CreateVirtualCluster(
+		ctx,
 		plan.Name.ValueString(),
 		api.ClusterParameters{
 			Type:   api.VirtualClusterTypeSchemaRegistry,
@@ -185,7 +199,7 @@ func (r *schemaRegistryResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
-	cluster, err = r.client.GetVirtualCluster(cluster.ID)
+	cluster, err = r.client.GetVirtualCluster(ctx, cluster.ID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading WarpStream Virtual Cluster",
@@ -231,7 +245,7 @@ func (r *schemaRegistryResource) Read(ctx context.Context, req resource.ReadRequ
 	// handling empty IDs, so let's explicitly handle them.
 	if state.ID.ValueString() == "" {
 		var err error
-		cluster, err = r.client.FindVirtualCluster(state.Name.ValueString())
+		cluster, err = r.client.FindVirtualCluster(ctx, state.Name.ValueString())
 		if err != nil {
 			if errors.Is(err, api.ErrNotFound) {
 				resp.State.RemoveResource(ctx)
@@ -246,7 +260,7 @@ func (r *schemaRegistryResource) Read(ctx context.Context, req resource.ReadRequ
 		state.ID = types.StringValue(cluster.ID)
 	}
 
-	cluster, err := r.client.GetVirtualCluster(state.ID.ValueString())
+	cluster, err := r.client.GetVirtualCluster(ctx, state.ID.ValueString())
 	if err != nil {
 		if errors.Is(err, api.ErrNotFound) {
 			resp.State.RemoveResource(ctx)
@@ -307,7 +321,7 @@ func (r *schemaRegistryResource) Update(ctx context.Context, req resource.Update
 
 	// Update tier if changed
 	if !plan.Tier.Equal(state.Tier) {
-		err := r.client.UpdateVirtualClusterTier(state.ID.ValueString(), plan.Tier.ValueString())
+		err := r.client.UpdateVirtualClusterTier(ctx, state.ID.ValueString(), plan.Tier.ValueString())
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error Updating WarpStream Schema Registry Tier",
@@ -317,7 +331,7 @@ func (r *schemaRegistryResource) Update(ctx context.Context, req resource.Update
 		}
 
 		// Read back the updated cluster to get the new tier
-		cluster, err := r.client.GetVirtualCluster(state.ID.ValueString())
+		cluster, err := r.client.GetVirtualCluster(ctx, state.ID.ValueString())
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error Reading WarpStream Virtual Cluster after tier update",
@@ -339,7 +353,7 @@ func (r *schemaRegistryResource) Delete(ctx context.Context, req resource.Delete
 		return
 	}
 
-	err := r.client.DeleteVirtualCluster(state.ID.ValueString(), state.Name.ValueString())
+	err := r.client.DeleteVirtualCluster(ctx, state.ID.ValueString(), state.Name.ValueString())
 	if err != nil {
 		if errors.Is(err, api.ErrNotFound) {
 			return
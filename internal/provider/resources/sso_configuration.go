@@ -21,6 +21,7 @@ var (
 	_ resource.Resource                = &ssoConfigurationResource{}
 	_ resource.ResourceWithConfigure   = &ssoConfigurationResource{}
 	_ resource.ResourceWithImportState = &ssoConfigurationResource{}
+	_ resource.ResourceWithModifyPlan  = &ssoConfigurationResource{}
 )
 
 // NewSSOConfigurationResource is a helper function to simplify the provider implementation.
@@ -50,6 +51,11 @@ func (r *ssoConfigurationResource) Configure(_ context.Context, req resource.Con
 		return
 	}
 
+	if err := client.RequireKeyKind("warpstream_sso_configuration", api.KeyKindAccount); err != nil {
+		resp.Diagnostics.AddError("Unsupported WarpStream API Key", err.Error())
+		return
+	}
+
 	r.client = client
 }
 
@@ -58,6 +64,10 @@ func (r *ssoConfigurationResource) Metadata(_ context.Context, req resource.Meta
 	resp.TypeName = req.ProviderTypeName + "_sso_configuration"
 }
 
+func (r *ssoConfigurationResource) ModifyPlan(_ context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	defer warnReadOnly(r.client, req, resp)
+}
+
 // Schema defines the schema for the resource.
 func (r *ssoConfigurationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
@@ -138,7 +148,7 @@ func (r *ssoConfigurationResource) Create(ctx context.Context, req resource.Crea
 	}
 
 	// Create new configuration
-	ssoConfigurationID, err := r.client.CreateSSOConfiguration(api.SSOConfigurationCreateRequest{
+	ssoConfigurationID, err := r.client.CreateSSOConfiguration(ctx, api.SSOConfigurationCreateRequest{
 		SSOIdentifier:        plan.SSOIdentifier.ValueString(),
 		EntityID:             plan.EntityID.ValueString(),
 		SAMLURL:              plan.SAMLURL.ValueString(),
@@ -155,7 +165,7 @@ func (r *ssoConfigurationResource) Create(ctx context.Context, req resource.Crea
 	}
 
 	// Describe created config
-	ssoConfig, err := r.client.GetSSOConfiguration(ssoConfigurationID)
+	ssoConfig, err := r.client.GetSSOConfiguration(ctx, ssoConfigurationID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading WarpStream SSO Configuration",
@@ -192,7 +202,7 @@ func (r *ssoConfigurationResource) Read(ctx context.Context, req resource.ReadRe
 		return
 	}
 
-	ssoConfig, err := r.client.GetSSOConfiguration(state.ID.ValueString())
+	ssoConfig, err := r.client.GetSSOConfiguration(ctx, state.ID.ValueString())
 	if err != nil {
 		if errors.Is(err, api.ErrNotFound) {
 			resp.State.RemoveResource(ctx)
@@ -236,7 +246,7 @@ func (r *ssoConfigurationResource) Update(ctx context.Context, req resource.Upda
 	}
 
 	// Update config.
-	err := r.client.UpdateSSOConfiguration(api.SSOConfigurationUpdateRequest{
+	err := r.client.UpdateSSOConfiguration(ctx, api.SSOConfigurationUpdateRequest{
 		SSOConnectionID:      plan.ID.ValueString(),
 		EntityID:             plan.EntityID.ValueString(),
 		SAMLURL:              plan.SAMLURL.ValueString(),
@@ -271,7 +281,7 @@ func (r *ssoConfigurationResource) Delete(ctx context.Context, req resource.Dele
 	}
 
 	// Delete existing workspace
-	err := r.client.DeleteSSOConfiguration(state.ID.ValueString())
+	err := r.client.DeleteSSOConfiguration(ctx, state.ID.ValueString())
 	if err != nil {
 		if errors.Is(err, api.ErrNotFound) {
 			return
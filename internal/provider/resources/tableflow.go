@@ -26,6 +26,7 @@ var (
 	_ resource.Resource                = &tableFlowResource{}
 	_ resource.ResourceWithConfigure   = &tableFlowResource{}
 	_ resource.ResourceWithImportState = &tableFlowResource{}
+	_ resource.ResourceWithModifyPlan  = &tableFlowResource{}
 )
 
 type tableFlowResource struct {
@@ -40,6 +41,13 @@ func (r *tableFlowResource) Metadata(_ context.Context, req resource.MetadataReq
 	resp.TypeName = req.ProviderTypeName + "_tableflow_cluster"
 }
 
+func (r *tableFlowResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	defer warnReadOnly(r.client, req, resp)
+
+	validateCloudRegion(ctx, r.client, req, resp)
+	checkPlannedWorkspace(ctx, r.client, req, resp)
+}
+
 func (r *tableFlowResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -56,6 +64,11 @@ func (r *tableFlowResource) Configure(_ context.Context, req resource.ConfigureR
 		return
 	}
 
+	if err := client.RequireKeyKind("warpstream_tableflow_cluster", api.KeyKindApplication, api.KeyKindAccount); err != nil {
+		resp.Diagnostics.AddError("Unsupported WarpStream API Key", err.Error())
+		return
+	}
+
 	r.client = client
 }
 
@@ -127,6 +140,7 @@ func (r *tableFlowResource) Create(ctx context.Context, req resource.CreateReque
 
 	// Create new virtual cluster
 	cluster, err := r.client.CreateVirtualCluster(
+		ctx,
 		plan.Name.ValueString(),
 		api.ClusterParameters{
 			Type:   api.VirtualClusterTypeTableFlow,
@@ -142,7 +156,7 @@ func (r *tableFlowResource) Create(ctx context.Context, req resource.CreateReque
 		return
 	}
 
-	cluster, err = r.client.GetVirtualCluster(cluster.ID)
+	cluster, err = r.client.GetVirtualCluster(ctx, cluster.ID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading WarpStream Virtual Cluster",
@@ -184,7 +198,7 @@ func (r *tableFlowResource) Read(ctx context.Context, req resource.ReadRequest,
 	// handling empty IDs, so let's explicitly handle them.
 	if state.ID.ValueString() == "" {
 		var err error
-		cluster, err = r.client.FindVirtualCluster(state.Name.ValueString())
+		cluster, err = r.client.FindVirtualCluster(ctx, state.Name.ValueString())
 		if err != nil {
 			if errors.Is(err, api.ErrNotFound) {
 				resp.State.RemoveResource(ctx)
@@ -199,7 +213,7 @@ func (r *tableFlowResource) Read(ctx context.Context, req resource.ReadRequest,
 		state.ID = types.StringValue(cluster.ID)
 	}
 
-	cluster, err := r.client.GetVirtualCluster(state.ID.ValueString())
+	cluster, err := r.client.GetVirtualCluster(ctx, state.ID.ValueString())
 	if err != nil {
 		if errors.Is(err, api.ErrNotFound) {
 			resp.State.RemoveResource(ctx)
@@ -257,7 +271,7 @@ func (r *tableFlowResource) Update(ctx context.Context, req resource.UpdateReque
 
 	// Update tier if changed
 	if !plan.Tier.Equal(state.Tier) {
-		err := r.client.UpdateVirtualClusterTier(state.ID.ValueString(), plan.Tier.ValueString())
+		err := r.client.UpdateVirtualClusterTier(ctx, state.ID.ValueString(), plan.Tier.ValueString())
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error Updating WarpStream TableFlow Tier",
@@ -267,7 +281,7 @@ func (r *tableFlowResource) Update(ctx context.Context, req resource.UpdateReque
 		}
 
 		// Read back the updated cluster to get the new tier
-		cluster, err := r.client.GetVirtualCluster(state.ID.ValueString())
+		cluster, err := r.client.GetVirtualCluster(ctx, state.ID.ValueString())
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error Reading WarpStream Virtual Cluster after tier update",
@@ -289,7 +303,7 @@ func (r *tableFlowResource) Delete(ctx context.Context, req resource.DeleteReque
 		return
 	}
 
-	err := r.client.DeleteVirtualCluster(state.ID.ValueString(), state.Name.ValueString())
+	err := r.client.DeleteVirtualCluster(ctx, state.ID.ValueString(), state.Name.ValueString())
 	if err != nil {
 		if errors.Is(err, api.ErrNotFound) {
 			return
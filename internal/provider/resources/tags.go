@@ -0,0 +1,59 @@
+package resources
+
+import (
+	"maps"
+
+	"github.com/warpstreamlabs/terraform-provider-warpstream/internal/provider/api"
+)
+
+// mergeTags returns the tags to store on a cluster: the provider's default tags, overridden by the
+// resource's own tags where both set a key.
+func mergeTags(defaults, tags map[string]string) map[string]string {
+	merged := make(map[string]string, len(defaults)+len(tags))
+	maps.Copy(merged, defaults)
+	maps.Copy(merged, tags)
+	return merged
+}
+
+// resourceTags picks the resource's own tags out of the tags stored on a cluster. A tag that
+// matches a provider default is left to `tags_all`, unless the resource itself sets that key, so
+// that default tags never show up as a diff on `tags`.
+func resourceTags(stored, defaults, configured map[string]string) map[string]string {
+	own := make(map[string]string, len(stored))
+	for k, v := range stored {
+		_, isConfigured := configured[k]
+		if d, isDefault := defaults[k]; isDefault && d == v && !isConfigured {
+			continue
+		}
+		own[k] = v
+	}
+	return own
+}
+
+// withoutIgnoredTags drops the tags matched by the provider's ignore_tags block, except for keys
+// the resource's own tags set, which Terraform still manages.
+func withoutIgnoredTags(tags map[string]string, ignore api.IgnoreTags, configured map[string]string) map[string]string {
+	kept := make(map[string]string, len(tags))
+	for k, v := range tags {
+		if _, isConfigured := configured[k]; ignore.Matches(k) && !isConfigured {
+			continue
+		}
+		kept[k] = v
+	}
+	return kept
+}
+
+// withIgnoredTags adds the ignored tags currently stored on a cluster to desired, so that
+// replacing the cluster's tags leaves them as they are.
+func withIgnoredTags(desired, stored map[string]string, ignore api.IgnoreTags) map[string]string {
+	merged := maps.Clone(desired)
+	if merged == nil {
+		merged = map[string]string{}
+	}
+	for k, v := range stored {
+		if _, isDesired := merged[k]; ignore.Matches(k) && !isDesired {
+			merged[k] = v
+		}
+	}
+	return merged
+}
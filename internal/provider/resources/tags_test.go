@@ -0,0 +1,85 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/warpstreamlabs/terraform-provider-warpstream/internal/provider/api"
+)
+
+func TestMergeTags(t *testing.T) {
+	t.Parallel()
+
+	defaults := map[string]string{"team": "platform", "env": "prod"}
+	tags := map[string]string{"env": "staging", "app": "orders"}
+
+	require.Equal(t,
+		map[string]string{"team": "platform", "env": "staging", "app": "orders"},
+		mergeTags(defaults, tags))
+	require.Equal(t, defaults, mergeTags(defaults, nil))
+	require.Empty(t, mergeTags(nil, nil))
+}
+
+func TestResourceTags(t *testing.T) {
+	t.Parallel()
+
+	defaults := map[string]string{"team": "platform", "env": "prod"}
+
+	tests := []struct {
+		name       string
+		stored     map[string]string
+		configured map[string]string
+		want       map[string]string
+	}{
+		{
+			name:   "defaults are left out",
+			stored: map[string]string{"team": "platform", "env": "prod", "app": "orders"},
+			want:   map[string]string{"app": "orders"},
+		},
+		{
+			name:       "resource keys that repeat a default are kept",
+			stored:     map[string]string{"team": "platform", "env": "prod"},
+			configured: map[string]string{"env": "prod"},
+			want:       map[string]string{"env": "prod"},
+		},
+		{
+			name:   "a default key changed outside Terraform shows up as drift",
+			stored: map[string]string{"team": "data", "env": "prod"},
+			want:   map[string]string{"team": "data"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			require.Equal(t, tt.want, resourceTags(tt.stored, defaults, tt.configured))
+		})
+	}
+}
+
+func TestWithoutIgnoredTags(t *testing.T) {
+	t.Parallel()
+
+	ignore := api.IgnoreTags{Keys: []string{"cost-center"}, KeyPrefixes: []string{"finops:"}}
+	stored := map[string]string{"cost-center": "42", "finops:owner": "billing", "app": "orders"}
+
+	require.Equal(t, map[string]string{"app": "orders"}, withoutIgnoredTags(stored, ignore, nil))
+	require.Equal(t,
+		map[string]string{"app": "orders", "cost-center": "42"},
+		withoutIgnoredTags(stored, ignore, map[string]string{"cost-center": "42"}))
+	require.Equal(t, stored, withoutIgnoredTags(stored, api.IgnoreTags{}, nil))
+}
+
+func TestWithIgnoredTags(t *testing.T) {
+	t.Parallel()
+
+	ignore := api.IgnoreTags{KeyPrefixes: []string{"finops:"}}
+	stored := map[string]string{"finops:owner": "billing", "app": "orders", "finops:budget": "old"}
+	desired := map[string]string{"app": "payments", "finops:budget": "new"}
+
+	require.Equal(t,
+		map[string]string{"app": "payments", "finops:owner": "billing", "finops:budget": "new"},
+		withIgnoredTags(desired, stored, ignore))
+	require.Equal(t, map[string]string{"finops:owner": "billing", "finops:budget": "old"}, withIgnoredTags(nil, stored, ignore))
+}
@@ -61,6 +61,11 @@ func (r *topicResource) Configure(_ context.Context, req resource.ConfigureReque
 		return
 	}
 
+	if err := client.RequireKeyKind("warpstream_topic", api.KeyKindApplication, api.KeyKindAccount); err != nil {
+		resp.Diagnostics.AddError("Unsupported WarpStream API Key", err.Error())
+		return
+	}
+
 	r.client = client
 }
 
@@ -84,9 +89,11 @@ The WarpStream provider must be authenticated with an application key to consume
 				},
 			},
 			"virtual_cluster_id": schema.StringAttribute{
-				Description: "Virtual Cluster ID associated with the Topic.",
-				Required:    true,
+				Description: "Virtual Cluster ID associated with the Topic. Defaults to the provider's `default_virtual_cluster_id`.",
+				Optional:    true,
+				Computed:    true,
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 				Validators: []validator.String{utils.StartsWithAndAlphanumeric("vci_")},
@@ -145,6 +152,11 @@ The WarpStream provider must be authenticated with an application key to consume
 }
 
 func (r *topicResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	defer warnReadOnly(r.client, req, resp)
+
+	planVirtualClusterID(ctx, r.client, req, resp)
+	checkPlannedClusterWorkspace(ctx, r.client, req, resp)
+
 	// Skip validation on create (no prior state) or destroy (no plan).
 	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
 		return
@@ -253,7 +265,7 @@ func (r *topicResource) Create(ctx context.Context, req resource.CreateRequest,
 	}
 	r.addDeletionProtectionInConfigMap(plan, configs)
 
-	err := r.client.CreateTopic(plan.VirtualClusterID.ValueString(), plan.TopicName.ValueString(), int(plan.PartitionCount.ValueInt64()), configs)
+	err := r.client.CreateTopic(ctx, plan.VirtualClusterID.ValueString(), plan.TopicName.ValueString(), int(plan.PartitionCount.ValueInt64()), configs)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Creating WarpStream Topic",
@@ -263,7 +275,7 @@ func (r *topicResource) Create(ctx context.Context, req resource.CreateRequest,
 	}
 
 	// Read it back so it gets set in state
-	topic, err := r.client.DescribeTopic(plan.VirtualClusterID.ValueString(), plan.TopicName.ValueString())
+	topic, err := r.client.DescribeTopic(ctx, plan.VirtualClusterID.ValueString(), plan.TopicName.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading WarpStream Topic",
@@ -330,7 +342,7 @@ func (r *topicResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
-	topic, err := r.client.DescribeTopic(state.VirtualClusterID.ValueString(), state.TopicName.ValueString())
+	topic, err := r.client.GetTopic(ctx, state.VirtualClusterID.ValueString(), state.TopicName.ValueString())
 	if err != nil {
 		if errors.Is(err, api.ErrNotFound) {
 			resp.State.RemoveResource(ctx)
@@ -401,7 +413,7 @@ func (r *topicResource) Update(ctx context.Context, req resource.UpdateRequest,
 	}
 
 	// Update topic resource
-	err := r.client.UpdateTopic(plan.VirtualClusterID.ValueString(), plan.TopicName.ValueString(), newPartitionCount, configs)
+	err := r.client.UpdateTopic(ctx, plan.VirtualClusterID.ValueString(), plan.TopicName.ValueString(), newPartitionCount, configs)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Creating WarpStream Topic",
@@ -411,7 +423,7 @@ func (r *topicResource) Update(ctx context.Context, req resource.UpdateRequest,
 	}
 
 	// Read it back so it gets set in state
-	topic, err := r.client.DescribeTopic(plan.VirtualClusterID.ValueString(), plan.TopicName.ValueString())
+	topic, err := r.client.DescribeTopic(ctx, plan.VirtualClusterID.ValueString(), plan.TopicName.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading WarpStream Topic",
@@ -454,7 +466,7 @@ func (r *topicResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
-	err := r.client.DeleteTopic(state.VirtualClusterID.ValueString(), state.TopicName.ValueString())
+	err := r.client.DeleteTopic(ctx, state.VirtualClusterID.ValueString(), state.TopicName.ValueString())
 	if err != nil {
 		if errors.Is(err, api.ErrNotFound) {
 			return
@@ -0,0 +1,530 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/warpstreamlabs/terraform-provider-warpstream/internal/provider/api"
+	"github.com/warpstreamlabs/terraform-provider-warpstream/internal/provider/models"
+	"github.com/warpstreamlabs/terraform-provider-warpstream/internal/provider/utils"
+)
+
+var (
+	_ resource.Resource                = &topicsResource{}
+	_ resource.ResourceWithConfigure   = &topicsResource{}
+	_ resource.ResourceWithModifyPlan  = &topicsResource{}
+	_ resource.ResourceWithImportState = &topicsResource{}
+)
+
+func NewTopicsResource() resource.Resource {
+	return &topicsResource{}
+}
+
+// topicsResource manages many topics of one virtual cluster as a single resource. Every apply and
+// refresh lists the cluster's topics once and only calls the API for the topics that differ.
+type topicsResource struct {
+	client *api.Client
+}
+
+func (r *topicsResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *api.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	if err := client.RequireKeyKind("warpstream_topics", api.KeyKindApplication, api.KeyKindAccount); err != nil {
+		resp.Diagnostics.AddError("Unsupported WarpStream API Key", err.Error())
+		return
+	}
+
+	r.client = client
+}
+
+func (r *topicsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_topics"
+}
+
+func (r *topicsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	topicConfigAttributes := map[string]schema.Attribute{
+		"name": schema.StringAttribute{
+			Required: true,
+		},
+		"value": schema.StringAttribute{
+			Required: true,
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		Description: `
+This resource allows you to create, update and delete many topics of a virtual cluster as a single resource.
+
+Every topic is deleted when the resource is destroyed, so topics that already exist are not adopted: planning or applying one that already exists fails.
+Import existing topics instead, with an ID of the form ` + "`virtual_cluster_id/topic_name[,topic_name...]`" + `.
+Set ` + "`warpstream.deletion.protection.enabled`" + ` to ` + "`true`" + ` in ` + "`config`" + ` to have WarpStream refuse to delete them.
+
+The WarpStream provider must be authenticated with an application key to consume this resource.
+`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the virtual cluster the topics belong to.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"virtual_cluster_id": schema.StringAttribute{
+				Description: "Virtual Cluster ID associated with the Topics. Defaults to the provider's `default_virtual_cluster_id`.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{utils.StartsWithAndAlphanumeric("vci_")},
+			},
+			"topics": schema.MapNestedAttribute{
+				Description: "The topics, keyed by topic name. Removing a topic from the map deletes it.",
+				Required:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"partition_count": schema.Int64Attribute{
+							Description: "Partition Count of the topic. It can't be decreased.",
+							Required:    true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(1),
+							},
+						},
+						"config": schema.SetNestedAttribute{
+							Description: "Configuration of the topic, which overrides the shared `config`.",
+							Optional:    true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: topicConfigAttributes,
+							},
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"config": schema.SetNestedBlock{
+				Description: "Configuration shared by every topic. See [WarpStream Topic Configuration](https://docs.warpstream.com/warpstream/kafka/reference/protocol-and-feature-support/topic-configuration-reference) for a list of supported configurations.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: topicConfigAttributes,
+				},
+			},
+		},
+	}
+}
+
+func (r *topicsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	defer warnReadOnly(r.client, req, resp)
+
+	planVirtualClusterID(ctx, r.client, req, resp)
+	checkPlannedClusterWorkspace(ctx, r.client, req, resp)
+
+	// The topics can only be checked once the virtual cluster and their values are known.
+	if resp.Diagnostics.HasError() || req.Plan.Raw.IsNull() || !resp.Plan.Raw.IsFullyKnown() {
+		return
+	}
+
+	var plan models.Topics
+	resp.Diagnostics.Append(resp.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state models.Topics
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(plan.Topics)) {
+		prior, ok := state.Topics[name]
+		if !ok {
+			continue
+		}
+
+		entry := plan.Topics[name]
+		topicPath := path.Root("topics").AtMapKey(name)
+		if entry.PartitionCount.ValueInt64() < prior.PartitionCount.ValueInt64() {
+			resp.Diagnostics.AddAttributeError(
+				topicPath.AtName("partition_count"),
+				"Invalid partition_count Decrease",
+				fmt.Sprintf("The partition count of topic %q cannot be decreased from %d to %d.",
+					name, prior.PartitionCount.ValueInt64(), entry.PartitionCount.ValueInt64()),
+			)
+		}
+
+		oldConfig := topicConfigList(mergeTopicConfigs(state.Config, prior.Config))
+		newConfig := topicConfigList(mergeTopicConfigs(plan.Config, entry.Config))
+		if err := validateCleanupPolicyChange(oldConfig, newConfig); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				topicPath,
+				"Invalid cleanup.policy Transition",
+				fmt.Sprintf("Topic %q: %s", name, err),
+			)
+		}
+	}
+
+	if r.client == nil || resp.Diagnostics.HasError() {
+		return
+	}
+
+	vcID := plan.VirtualClusterID.ValueString()
+	topics, err := r.client.ListTopics(ctx, vcID)
+	if err != nil {
+		// Apply reports the error, if it persists.
+		tflog.Warn(ctx, "Unable to list topics to plan warpstream_topics", map[string]any{"virtual_cluster_id": vcID, "error": err.Error()})
+		return
+	}
+	for _, name := range unmanagedTopics(topics, plan.Topics, state.Topics) {
+		addUnmanagedTopicError(vcID, name, &resp.Diagnostics)
+	}
+}
+
+// unmanagedTopics returns the names of the desired topics that already exist but aren't in prior.
+// These aren't adopted, since destroying the resource would then delete them.
+func unmanagedTopics(topics []api.Topic, desired, prior map[string]models.TopicsEntry) []string {
+	var unmanaged []string
+	for _, topic := range topics {
+		_, isDesired := desired[topic.TopicName]
+		_, isManaged := prior[topic.TopicName]
+		if isDesired && !isManaged {
+			unmanaged = append(unmanaged, topic.TopicName)
+		}
+	}
+	slices.Sort(unmanaged)
+	return unmanaged
+}
+
+// addUnmanagedTopicError reports a topic that unmanagedTopics found.
+func addUnmanagedTopicError(vcID, name string, diags *diag.Diagnostics) {
+	diags.AddError(
+		"WarpStream Topic Already Exists",
+		"WarpStream Topic ID "+vcID+"/"+name+" already exists and is not managed by this resource. "+
+			"Import it with `terraform import` to manage it here, or remove it from `topics`.",
+	)
+}
+
+// mergeTopicConfigs returns the configs to set on a topic: the shared configs, overridden by the
+// topic's own.
+func mergeTopicConfigs(shared, overrides []models.TopicConfig) map[string]string {
+	configs := make(map[string]string, len(shared)+len(overrides))
+	for _, c := range shared {
+		configs[c.Name.ValueString()] = c.Value.ValueString()
+	}
+	for _, c := range overrides {
+		configs[c.Name.ValueString()] = c.Value.ValueString()
+	}
+	return configs
+}
+
+// topicConfigList converts merged configs back into the form validateCleanupPolicyChange takes.
+func topicConfigList(configs map[string]string) []models.TopicConfig {
+	list := make([]models.TopicConfig, 0, len(configs))
+	for _, name := range slices.Sorted(maps.Keys(configs)) {
+		list = append(list, models.TopicConfig{
+			Name:  types.StringValue(name),
+			Value: types.StringValue(configs[name]),
+		})
+	}
+	return list
+}
+
+// topicNeedsUpdate reports whether an existing topic differs from its planned partition count or
+// configs. Configs the plan doesn't set are left alone, like they are by warpstream_topic.
+func topicNeedsUpdate(topic api.Topic, partitionCount int, configs map[string]string) bool {
+	if topic.PartitionCount != partitionCount {
+		return true
+	}
+	for name, value := range configs {
+		if actual := topic.Configs[name]; actual == nil || *actual != value {
+			return true
+		}
+	}
+	return false
+}
+
+// refreshTopicsEntry returns the state of a topic from the API. Only the configs the topic
+// overrides are read back, except that a shared config the topic no longer matches is also read
+// back as an override, so that the next plan sets it again.
+func refreshTopicsEntry(topic api.Topic, shared []models.TopicConfig, entry models.TopicsEntry) models.TopicsEntry {
+	overridden := make(map[string]bool, len(entry.Config))
+	var configs []models.TopicConfig
+	for _, c := range entry.Config {
+		name := c.Name.ValueString()
+		overridden[name] = true
+		if value, ok := topic.Configs[name]; ok {
+			configs = append(configs, models.TopicConfig{Name: c.Name, Value: types.StringPointerValue(value)})
+		}
+	}
+	for _, c := range shared {
+		name := c.Name.ValueString()
+		if overridden[name] {
+			continue
+		}
+		if value := topic.Configs[name]; value != nil && *value != c.Value.ValueString() {
+			configs = append(configs, models.TopicConfig{Name: c.Name, Value: types.StringPointerValue(value)})
+		}
+	}
+
+	// Keep an empty set empty rather than null, so that it matches the configuration.
+	if configs == nil && entry.Config != nil {
+		configs = []models.TopicConfig{}
+	}
+
+	return models.TopicsEntry{
+		PartitionCount: types.Int64Value(int64(topic.PartitionCount)),
+		Config:         configs,
+	}
+}
+
+// applyTopics creates and updates the desired topics and deletes the prior ones that are no longer
+// desired, diffing them all against a single list of the virtual cluster's topics. It carries on
+// past a failing topic so that one bad topic doesn't hold up the rest, and returns the topics the
+// resource manages afterwards: those it applied, and prior ones it failed to change or delete.
+//
+// A desired topic that already exists but isn't in prior is an error rather than being adopted,
+// since destroying the resource would then delete it. Such topics must be imported.
+func (r *topicsResource) applyTopics(ctx context.Context, vcID string, shared []models.TopicConfig, desired, prior map[string]models.TopicsEntry, diags *diag.Diagnostics) map[string]models.TopicsEntry {
+	managed := make(map[string]models.TopicsEntry, len(desired))
+
+	topics, err := r.client.ListTopics(ctx, vcID)
+	if errors.Is(err, api.ErrNotFound) && len(desired) == 0 {
+		// The virtual cluster is gone, and its topics with it.
+		return managed
+	}
+	if err != nil {
+		diags.AddError(
+			"Error Reading WarpStream Topics",
+			"Could not list WarpStream Topics of "+vcID+": "+err.Error(),
+		)
+		return maps.Clone(prior)
+	}
+
+	existing := make(map[string]api.Topic, len(topics))
+	for _, topic := range topics {
+		existing[topic.TopicName] = topic
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(desired)) {
+		configs := mergeTopicConfigs(shared, desired[name].Config)
+		partitionCount := int(desired[name].PartitionCount.ValueInt64())
+		payload := make(map[string]*string, len(configs))
+		for k, v := range configs {
+			payload[k] = &v
+		}
+
+		topic, ok := existing[name]
+		_, wasManaged := prior[name]
+		switch {
+		case !ok:
+			if err := r.client.CreateTopic(ctx, vcID, name, partitionCount, payload); err != nil {
+				diags.AddError(
+					"Error Creating WarpStream Topic",
+					"Could not create WarpStream Topic ID "+vcID+"/"+name+": "+err.Error(),
+				)
+				continue
+			}
+		case !wasManaged:
+			addUnmanagedTopicError(vcID, name, diags)
+			continue
+		case topicNeedsUpdate(topic, partitionCount, configs):
+			var newPartitionCount *int
+			if topic.PartitionCount != partitionCount {
+				newPartitionCount = &partitionCount
+			}
+			if err := r.client.UpdateTopic(ctx, vcID, name, newPartitionCount, payload); err != nil {
+				diags.AddError(
+					"Error Updating WarpStream Topic",
+					"Could not update WarpStream Topic ID "+vcID+"/"+name+": "+err.Error(),
+				)
+				managed[name] = prior[name]
+				continue
+			}
+		}
+		managed[name] = desired[name]
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(prior)) {
+		if _, ok := desired[name]; ok {
+			continue
+		}
+		if _, ok := existing[name]; !ok {
+			continue
+		}
+
+		err := r.client.DeleteTopic(ctx, vcID, name)
+		if err != nil && !errors.Is(err, api.ErrNotFound) {
+			diags.AddError(
+				"Error Deleting WarpStream Topic",
+				"Could not delete WarpStream Topic ID "+vcID+"/"+name+": "+err.Error(),
+			)
+			managed[name] = prior[name]
+		}
+	}
+
+	return managed
+}
+
+// readTopics returns the state of the given topics from a single list of the virtual cluster's
+// topics. Topics that no longer exist are left out.
+func (r *topicsResource) readTopics(ctx context.Context, vcID string, shared []models.TopicConfig, entries map[string]models.TopicsEntry) (map[string]models.TopicsEntry, error) {
+	topics, err := r.client.ListTopics(ctx, vcID)
+	if err != nil {
+		return nil, err
+	}
+
+	state := make(map[string]models.TopicsEntry, len(entries))
+	for _, topic := range topics {
+		if entry, ok := entries[topic.TopicName]; ok {
+			state[topic.TopicName] = refreshTopicsEntry(topic, shared, entry)
+		}
+	}
+	return state, nil
+}
+
+func (r *topicsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan models.Topics
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// On failure, the topics that were created are still saved so that they are tracked. Terraform
+	// then taints the resource, and replacing it only deletes the topics this resource created.
+	vcID := plan.VirtualClusterID.ValueString()
+	managed := r.applyTopics(ctx, vcID, plan.Config, plan.Topics, nil, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() && len(managed) == 0 {
+		return
+	}
+
+	topics, err := r.readTopics(ctx, vcID, plan.Config, managed)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading WarpStream Topics",
+			"Could not read WarpStream Topics of "+vcID+": "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(vcID)
+	plan.Topics = topics
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *topicsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state models.Topics
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	topics, err := r.readTopics(ctx, state.VirtualClusterID.ValueString(), state.Config, state.Topics)
+	if err != nil {
+		if errors.Is(err, api.ErrNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Reading WarpStream Topics",
+			"Could not read WarpStream Topics of "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	state.Topics = topics
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *topicsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan models.Topics
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state models.Topics
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Read back the topics the resource still manages, including any removed topic that failed to
+	// delete so that the next apply tries again.
+	vcID := plan.VirtualClusterID.ValueString()
+	managed := r.applyTopics(ctx, vcID, plan.Config, plan.Topics, state.Topics, &resp.Diagnostics)
+
+	topics, err := r.readTopics(ctx, vcID, plan.Config, managed)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading WarpStream Topics",
+			"Could not read WarpStream Topics of "+vcID+": "+err.Error(),
+		)
+		return
+	}
+
+	plan.Topics = topics
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *topicsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state models.Topics
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.applyTopics(ctx, state.VirtualClusterID.ValueString(), state.Config, nil, state.Topics, &resp.Diagnostics)
+}
+
+// ImportState imports the listed topics of a virtual cluster, from an ID of the form
+// virtual_cluster_id/topic_name[,topic_name...]. Read then fills them in.
+func (r *topicsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	vcID, names, ok := strings.Cut(req.ID, "/")
+	if !ok || vcID == "" || names == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			"Expected an ID in the format virtual_cluster_id/topic_name[,topic_name...]",
+		)
+		return
+	}
+
+	topics := make(map[string]models.TopicsEntry)
+	for _, name := range strings.Split(names, ",") {
+		topics[name] = models.TopicsEntry{PartitionCount: types.Int64Null()}
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &models.Topics{
+		ID:               types.StringValue(vcID),
+		VirtualClusterID: types.StringValue(vcID),
+		Topics:           topics,
+	})...)
+}
@@ -0,0 +1,183 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/warpstreamlabs/terraform-provider-warpstream/internal/provider/api"
+	"github.com/warpstreamlabs/terraform-provider-warpstream/internal/provider/apitest/fakeserver"
+	"github.com/warpstreamlabs/terraform-provider-warpstream/internal/provider/models"
+)
+
+func topicConfigs(kv ...string) []models.TopicConfig {
+	configs := make([]models.TopicConfig, 0, len(kv)/2)
+	for i := 0; i < len(kv); i += 2 {
+		configs = append(configs, models.TopicConfig{Name: types.StringValue(kv[i]), Value: types.StringValue(kv[i+1])})
+	}
+	return configs
+}
+
+func topicsEntry(partitionCount int64, config ...string) models.TopicsEntry {
+	entry := models.TopicsEntry{PartitionCount: types.Int64Value(partitionCount)}
+	if len(config) > 0 {
+		entry.Config = topicConfigs(config...)
+	}
+	return entry
+}
+
+func TestMergeTopicConfigs(t *testing.T) {
+	t.Parallel()
+
+	got := mergeTopicConfigs(
+		topicConfigs("retention.ms", "1000", "cleanup.policy", "delete"),
+		topicConfigs("retention.ms", "2000"),
+	)
+	require.Equal(t, map[string]string{"retention.ms": "2000", "cleanup.policy": "delete"}, got)
+}
+
+func TestTopicNeedsUpdate(t *testing.T) {
+	t.Parallel()
+
+	retention, other := "1000", "2000"
+	topic := api.Topic{
+		TopicName:      "orders",
+		PartitionCount: 2,
+		Configs:        map[string]*string{"retention.ms": &retention, "segment.ms": &other},
+	}
+
+	require.False(t, topicNeedsUpdate(topic, 2, map[string]string{"retention.ms": "1000"}))
+	require.True(t, topicNeedsUpdate(topic, 3, map[string]string{"retention.ms": "1000"}))
+	require.True(t, topicNeedsUpdate(topic, 2, map[string]string{"retention.ms": "2000"}))
+	require.True(t, topicNeedsUpdate(topic, 2, map[string]string{"cleanup.policy": "delete"}))
+}
+
+func TestRefreshTopicsEntry(t *testing.T) {
+	t.Parallel()
+
+	retention, policy, segment := "1000", "compact", "3000"
+	topic := api.Topic{
+		TopicName:      "orders",
+		PartitionCount: 4,
+		Configs: map[string]*string{
+			"retention.ms":   &retention,
+			"cleanup.policy": &policy,
+			"segment.ms":     &segment,
+		},
+	}
+	shared := topicConfigs("cleanup.policy", "compact", "segment.ms", "9000")
+
+	got := refreshTopicsEntry(topic, shared, topicsEntry(2, "retention.ms", "5000"))
+	require.Equal(t, types.Int64Value(4), got.PartitionCount)
+	// The override is read back, and the drifted shared segment.ms shows up as an override.
+	require.ElementsMatch(t, topicConfigs("retention.ms", "1000", "segment.ms", "3000"), got.Config)
+
+	matching := refreshTopicsEntry(topic, topicConfigs("cleanup.policy", "compact"), models.TopicsEntry{
+		PartitionCount: types.Int64Value(4),
+		Config:         []models.TopicConfig{},
+	})
+	require.NotNil(t, matching.Config)
+	require.Empty(t, matching.Config)
+}
+
+func TestUnmanagedTopics(t *testing.T) {
+	t.Parallel()
+
+	topics := []api.Topic{{TopicName: "payments"}, {TopicName: "orders"}, {TopicName: "stale"}, {TopicName: "audit"}}
+	desired := map[string]models.TopicsEntry{
+		"audit":    topicsEntry(1),
+		"orders":   topicsEntry(2),
+		"payments": topicsEntry(1),
+		"refunds":  topicsEntry(1),
+	}
+	prior := map[string]models.TopicsEntry{"orders": topicsEntry(1)}
+
+	require.Equal(t, []string{"audit", "payments"}, unmanagedTopics(topics, desired, prior))
+	require.Empty(t, unmanagedTopics(topics, map[string]models.TopicsEntry{"orders": topicsEntry(2)}, prior))
+}
+
+func TestApplyTopics(t *testing.T) {
+	t.Parallel()
+
+	server := fakeserver.New()
+	t.Cleanup(server.Close)
+
+	token := "aks_test"
+	opts := api.DefaultClientOptions()
+	opts.MaxRetries = 0
+	client, err := api.NewClient(server.URL(), &token, "test", opts)
+	require.NoError(t, err)
+
+	ctx := t.Context()
+	vc, err := client.GetDefaultCluster(ctx)
+	require.NoError(t, err)
+	require.NoError(t, client.CreateTopic(ctx, vc.ID, "stale", 1, nil))
+
+	r := &topicsResource{client: client}
+	shared := topicConfigs("retention.ms", "1000")
+
+	var diags diag.Diagnostics
+	desired := map[string]models.TopicsEntry{
+		"orders":   topicsEntry(2),
+		"payments": topicsEntry(1, "retention.ms", "2000"),
+	}
+	managed := r.applyTopics(ctx, vc.ID, shared, desired, nil, &diags)
+	require.False(t, diags.HasError(), "%v", diags)
+	require.Equal(t, desired, managed)
+
+	state, err := r.readTopics(ctx, vc.ID, shared, desired)
+	require.NoError(t, err)
+	require.Equal(t, desired, state)
+
+	// Grow one topic, drop another, and leave the unmanaged topic alone.
+	prior := state
+	desired = map[string]models.TopicsEntry{
+		"orders": topicsEntry(3),
+	}
+	managed = r.applyTopics(ctx, vc.ID, shared, desired, prior, &diags)
+	require.False(t, diags.HasError(), "%v", diags)
+	require.Equal(t, desired, managed)
+
+	topics, err := client.ListTopics(ctx, vc.ID)
+	require.NoError(t, err)
+	names := make(map[string]int, len(topics))
+	for _, topic := range topics {
+		names[topic.TopicName] = topic.PartitionCount
+	}
+	require.Equal(t, map[string]int{"orders": 3, "stale": 1}, names)
+}
+
+func TestApplyTopicsRejectsExistingTopics(t *testing.T) {
+	t.Parallel()
+
+	server := fakeserver.New()
+	t.Cleanup(server.Close)
+
+	token := "aks_test"
+	opts := api.DefaultClientOptions()
+	opts.MaxRetries = 0
+	client, err := api.NewClient(server.URL(), &token, "test", opts)
+	require.NoError(t, err)
+
+	ctx := t.Context()
+	vc, err := client.GetDefaultCluster(ctx)
+	require.NoError(t, err)
+	require.NoError(t, client.CreateTopic(ctx, vc.ID, "existing", 1, nil))
+
+	r := &topicsResource{client: client}
+	var diags diag.Diagnostics
+	managed := r.applyTopics(ctx, vc.ID, nil, map[string]models.TopicsEntry{
+		"existing": topicsEntry(3),
+		"orders":   topicsEntry(2),
+	}, nil, &diags)
+
+	require.True(t, diags.HasError())
+	require.Equal(t, "WarpStream Topic Already Exists", diags.Errors()[0].Summary())
+	// The new topic is still created and managed, and the existing one is left alone.
+	require.Equal(t, map[string]models.TopicsEntry{"orders": topicsEntry(2)}, managed)
+	topic, err := client.DescribeTopic(ctx, vc.ID, "existing")
+	require.NoError(t, err)
+	require.Equal(t, 1, topic.PartitionCount)
+}
@@ -21,8 +21,9 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource              = &userRoleResource{}
-	_ resource.ResourceWithConfigure = &userRoleResource{}
+	_ resource.Resource               = &userRoleResource{}
+	_ resource.ResourceWithConfigure  = &userRoleResource{}
+	_ resource.ResourceWithModifyPlan = &userRoleResource{}
 	// Names of the managed grants that can be assigned to a role inside a workspace.
 	ManagedGrantNames = []string{"admin", "read_only", "billing"}
 )
@@ -54,6 +55,11 @@ func (r *userRoleResource) Configure(_ context.Context, req resource.ConfigureRe
 		return
 	}
 
+	if err := client.RequireKeyKind("warpstream_user_role", api.KeyKindAccount); err != nil {
+		resp.Diagnostics.AddError("Unsupported WarpStream API Key", err.Error())
+		return
+	}
+
 	r.client = client
 }
 
@@ -62,6 +68,12 @@ func (r *userRoleResource) Metadata(_ context.Context, req resource.MetadataRequ
 	resp.TypeName = req.ProviderTypeName + "_user_role"
 }
 
+func (r *userRoleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	defer warnReadOnly(r.client, req, resp)
+
+	checkPlannedGrantWorkspaces(ctx, r.client, req, resp)
+}
+
 var grantSchema = schema.NestedAttributeObject{
 	Attributes: map[string]schema.Attribute{
 		"workspace_id": schema.StringAttribute{
@@ -156,7 +168,7 @@ func (r *userRoleResource) Create(ctx context.Context, req resource.CreateReques
 	}
 
 	// Create the new role
-	newRoleID, err := r.client.CreateUserRole(plan.Name.ValueString(), grants)
+	newRoleID, err := r.client.CreateUserRole(ctx, plan.Name.ValueString(), grants)
 	if err != nil {
 		details := "Could not create WarpStream User Role, unexpected error: " + err.Error()
 		// TODO: Make the API client return more specific errors so that we know for sure when the 404 is due to a missing workspace.
@@ -173,7 +185,7 @@ func (r *userRoleResource) Create(ctx context.Context, req resource.CreateReques
 	}
 
 	// Describe created role
-	role, err := r.client.GetUserRole(newRoleID)
+	role, err := r.client.GetUserRole(ctx, newRoleID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading WarpStream User Role",
@@ -214,7 +226,7 @@ func (r *userRoleResource) Read(ctx context.Context, req resource.ReadRequest, r
 		return
 	}
 
-	role, err := r.client.GetUserRole(state.ID.ValueString())
+	role, err := r.client.GetUserRole(ctx, state.ID.ValueString())
 	if err != nil {
 		if errors.Is(err, api.ErrNotFound) {
 			resp.State.RemoveResource(ctx)
@@ -271,7 +283,7 @@ func (r *userRoleResource) Update(ctx context.Context, req resource.UpdateReques
 		})
 	}
 
-	err := r.client.UpdateUserRole(plan.ID.ValueString(), plan.Name.ValueString(), grants)
+	err := r.client.UpdateUserRole(ctx, plan.ID.ValueString(), plan.Name.ValueString(), grants)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Updating WarpStream User Role",
@@ -281,7 +293,7 @@ func (r *userRoleResource) Update(ctx context.Context, req resource.UpdateReques
 	}
 
 	// Describe updated role
-	role, err := r.client.GetUserRole(plan.ID.ValueString())
+	role, err := r.client.GetUserRole(ctx, plan.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading WarpStream User Role",
@@ -326,7 +338,7 @@ func (r *userRoleResource) Delete(ctx context.Context, req resource.DeleteReques
 	}
 
 	// Delete existing role
-	err := r.client.DeleteUserRole(state.ID.ValueString())
+	err := r.client.DeleteUserRole(ctx, state.ID.ValueString())
 	if err != nil {
 		if errors.Is(err, api.ErrNotFound) {
 			return
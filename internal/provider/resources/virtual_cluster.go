@@ -69,6 +69,11 @@ func (r *virtualClusterResource) Configure(_ context.Context, req resource.Confi
 		return
 	}
 
+	if err := client.RequireKeyKind("warpstream_virtual_cluster", api.KeyKindApplication, api.KeyKindAccount); err != nil {
+		resp.Diagnostics.AddError("Unsupported WarpStream API Key", err.Error())
+		return
+	}
+
 	r.client = client
 }
 
@@ -78,11 +83,18 @@ func (r *virtualClusterResource) Metadata(_ context.Context, req resource.Metada
 }
 
 func (r *virtualClusterResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
-	// Nothing to validate on destroy.
+	defer warnReadOnly(r.client, req, resp)
+
+	checkPlannedWorkspace(ctx, r.client, req, resp)
+
+	// Nothing else to validate on destroy.
 	if req.Plan.Raw.IsNull() {
 		return
 	}
 
+	validateCloudRegion(ctx, r.client, req, resp)
+	r.planTagsAll(ctx, req, resp)
+
 	var declared types.Map
 	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("broker_configuration"), &declared)...)
 	if resp.Diagnostics.HasError() || declared.IsNull() || declared.IsUnknown() {
@@ -92,6 +104,36 @@ func (r *virtualClusterResource) ModifyPlan(ctx context.Context, req resource.Mo
 	validateBrokerConfiguration(declared, path.Root("broker_configuration"), &resp.Diagnostics)
 }
 
+// planTagsAll plans `tags_all` as the provider's default tags merged with the planned `tags`, so a
+// change to default_tags shows up on `tags_all` alone.
+func (r *virtualClusterResource) planTagsAll(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.client == nil {
+		return
+	}
+
+	var tags types.Map
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("tags"), &tags)...)
+	if resp.Diagnostics.HasError() || tags.IsUnknown() {
+		return
+	}
+
+	var tagsMap map[string]string
+	if !tags.IsNull() {
+		resp.Diagnostics.Append(tags.ElementsAs(ctx, &tagsMap, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	tagsAll, diags := types.MapValueFrom(ctx, types.StringType,
+		withoutIgnoredTags(mergeTags(r.client.DefaultTags, tagsMap), r.client.IgnoreTags, tagsMap))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("tags_all"), tagsAll)...)
+}
+
 // brokerConfigMap extracts the known entries of a `broker_configuration` map into a plain Go
 // map.
 func brokerConfigMap(m types.Map) map[string]string {
@@ -242,7 +284,8 @@ The WarpStream provider must be authenticated with an application key to consume
 				},
 			},
 			"tags": schema.MapAttribute{
-				Description: "Tags associated with the virtual cluster.",
+				Description: "Tags associated with the virtual cluster. " +
+					"Overrides tags with the same key in the provider's `default_tags` block.",
 				Optional:    true,
 				Computed:    true,
 				ElementType: types.StringType,
@@ -250,6 +293,11 @@ The WarpStream provider must be authenticated with an application key to consume
 					mapplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"tags_all": schema.MapAttribute{
+				Description: "All tags on the virtual cluster, including those inherited from the provider's `default_tags` block.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
 			"configuration": schema.SingleNestedAttribute{
 				Attributes: map[string]schema.Attribute{
 					"auto_create_topic": schema.BoolAttribute{
@@ -432,9 +480,11 @@ func (r *virtualClusterResource) Create(ctx context.Context, req resource.Create
 			return
 		}
 	}
+	tagsMap = mergeTags(r.client.DefaultTags, tagsMap)
 
 	// Create new virtual cluster
 	cluster, err := r.client.CreateVirtualCluster(
+		ctx,
 		plan.Name.ValueString(),
 		api.ClusterParameters{
 			Type:        plan.Type.ValueString(),
@@ -456,7 +506,7 @@ func (r *virtualClusterResource) Create(ctx context.Context, req resource.Create
 
 	// Describe created virtual cluster
 	clusterID := cluster.ID
-	cluster, err = r.client.GetVirtualCluster(clusterID)
+	cluster, err = r.client.GetVirtualCluster(ctx, clusterID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading WarpStream Virtual Cluster",
@@ -486,6 +536,7 @@ func (r *virtualClusterResource) Create(ctx context.Context, req resource.Create
 		Events:              plan.Events,
 		Cloud:               cloudValue,
 		Tags:                plan.Tags,
+		TagsAll:             plan.TagsAll,
 	}
 
 	if cluster.BootstrapURL != nil {
@@ -499,7 +550,7 @@ func (r *virtualClusterResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
-	r.readTags(ctx, *cluster, &resp.State, &resp.Diagnostics)
+	r.readTags(ctx, *cluster, plan.Tags, &resp.State, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -551,7 +602,7 @@ func (r *virtualClusterResource) Read(ctx context.Context, req resource.ReadRequ
 	// handling empty IDs, so let's explicitly handle them.
 	if state.ID.ValueString() == "" {
 		var err error
-		cluster, err = r.client.FindVirtualCluster(state.Name.ValueString())
+		cluster, err = r.client.FindVirtualCluster(ctx, state.Name.ValueString())
 		if err != nil {
 			if errors.Is(err, api.ErrNotFound) {
 				resp.State.RemoveResource(ctx)
@@ -566,7 +617,7 @@ func (r *virtualClusterResource) Read(ctx context.Context, req resource.ReadRequ
 		state.ID = types.StringValue(cluster.ID)
 	}
 
-	cluster, err := r.client.GetVirtualCluster(state.ID.ValueString())
+	cluster, err := r.client.GetVirtualCluster(ctx, state.ID.ValueString())
 	if err != nil {
 		if errors.Is(err, api.ErrNotFound) {
 			resp.State.RemoveResource(ctx)
@@ -638,7 +689,7 @@ func (r *virtualClusterResource) Read(ctx context.Context, req resource.ReadRequ
 	}
 
 	r.readEvents(ctx, *cluster, &resp.State, &resp.Diagnostics, eventTypesFilter)
-	r.readTags(ctx, *cluster, &resp.State, &resp.Diagnostics)
+	r.readTags(ctx, *cluster, state.Tags, &resp.State, &resp.Diagnostics)
 }
 
 // Update updates the resource and sets the updated Terraform state on success.
@@ -661,7 +712,7 @@ func (r *virtualClusterResource) Update(ctx context.Context, req resource.Update
 
 	// Rename virtual cluster if name has changed.
 	if plan.Name.ValueString() != state.Name.ValueString() {
-		err := r.client.RenameVirtualCluster(state.ID.ValueString(), plan.Name.ValueString())
+		err := r.client.RenameVirtualCluster(ctx, state.ID.ValueString(), plan.Name.ValueString())
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error Renaming WarpStream Virtual Cluster",
@@ -689,8 +740,9 @@ func (r *virtualClusterResource) Update(ctx context.Context, req resource.Update
 		return
 	}
 
-	// Update tags if they have changed
-	if !plan.Tags.IsUnknown() && !state.Tags.IsUnknown() && !plan.Tags.Equal(state.Tags) {
+	// Update tags if they, or the default tags merged into them, have changed
+	tagsChanged := !plan.Tags.Equal(state.Tags) || !plan.TagsAll.Equal(state.TagsAll)
+	if !plan.Tags.IsUnknown() && !state.Tags.IsUnknown() && tagsChanged {
 		stateWithPlanTags := state
 		stateWithPlanTags.Tags = plan.Tags
 		r.applyTags(ctx, stateWithPlanTags, &resp.State, &resp.Diagnostics)
@@ -708,7 +760,7 @@ func (r *virtualClusterResource) Delete(ctx context.Context, req resource.Delete
 	}
 
 	// Delete existing virtual cluster
-	err := r.client.DeleteVirtualCluster(state.ID.ValueString(), state.Name.ValueString())
+	err := r.client.DeleteVirtualCluster(ctx, state.ID.ValueString(), state.Name.ValueString())
 	if err != nil {
 		if errors.Is(err, api.ErrNotFound) {
 			return
@@ -816,7 +868,7 @@ func (r *virtualClusterResource) readConfiguration(ctx context.Context, cluster
 	declared types.Map, keepTopicTypeNull bool, state *tfsdk.State, respDiags *diag.Diagnostics,
 ) *api.VirtualClusterConfiguration {
 	// Get virtual cluster configuration
-	cfg, err := r.client.GetConfiguration(cluster)
+	cfg, err := r.client.GetConfiguration(ctx, cluster)
 	if err != nil {
 		respDiags.AddError(
 			"Unable to Read configuration of Virtual Cluster with ID="+cluster.ID,
@@ -904,7 +956,7 @@ func (r *virtualClusterResource) applyConfiguration(ctx context.Context, plan mo
 		Tier:                     plan.Tier.ValueString(),
 		BrokerConfigs:            brokerConfigs,
 	}
-	if err := r.client.UpdateConfiguration(cfg, cluster); err != nil {
+	if err := r.client.UpdateConfiguration(ctx, cfg, cluster); err != nil {
 		respDiags.AddError(
 			"Error Updating WarpStream Virtual Cluster Configuration",
 			"Could not update WarpStream Virtual Cluster Configuration, unexpected error: "+err.Error(),
@@ -930,8 +982,11 @@ func (r *virtualClusterResource) applyConfiguration(ctx context.Context, plan mo
 
 }
 
-func (r *virtualClusterResource) readTags(ctx context.Context, cluster api.VirtualCluster, state *tfsdk.State, respDiags *diag.Diagnostics) {
-	tags, err := r.client.GetTags(cluster)
+// readTags sets `tags_all` to every tag on the cluster and `tags` to the cluster's own tags.
+// Default tags are left out of `tags`, and ignored tags out of both, unless configured, the
+// resource's prior `tags`, sets the key.
+func (r *virtualClusterResource) readTags(ctx context.Context, cluster api.VirtualCluster, configured types.Map, state *tfsdk.State, respDiags *diag.Diagnostics) {
+	tags, err := r.client.GetTags(ctx, cluster)
 	if err != nil {
 		respDiags.AddError(
 			"Unable to Read tags of Virtual Cluster with ID="+cluster.ID,
@@ -941,17 +996,31 @@ func (r *virtualClusterResource) readTags(ctx context.Context, cluster api.Virtu
 	}
 	tflog.Debug(ctx, fmt.Sprintf("Tags: %+v", tags))
 
-	tagsMap := make(map[string]attr.Value)
-	for k, v := range tags {
-		tagsMap[k] = types.StringValue(v)
+	var configuredMap map[string]string
+	if !configured.IsNull() && !configured.IsUnknown() {
+		diags := configured.ElementsAs(ctx, &configuredMap, false)
+		respDiags.Append(diags...)
+		if respDiags.HasError() {
+			return
+		}
 	}
 
-	tagsValue, diags := types.MapValue(types.StringType, tagsMap)
+	tags = withoutIgnoredTags(tags, r.client.IgnoreTags, configuredMap)
+	tagsAllValue, diags := types.MapValueFrom(ctx, types.StringType, tags)
 	respDiags.Append(diags...)
 	if respDiags.HasError() {
 		return
 	}
 
+	ownTags := resourceTags(tags, r.client.DefaultTags, configuredMap)
+	tagsValue, diags := types.MapValueFrom(ctx, types.StringType, ownTags)
+	respDiags.Append(diags...)
+	if respDiags.HasError() {
+		return
+	}
+
+	diags = state.SetAttribute(ctx, path.Root("tags_all"), tagsAllValue)
+	respDiags.Append(diags...)
 	diags = state.SetAttribute(ctx, path.Root("tags"), tagsValue)
 	respDiags.Append(diags...)
 }
@@ -971,7 +1040,21 @@ func (r *virtualClusterResource) applyTags(ctx context.Context, state models.Vir
 		return
 	}
 
-	err := r.client.UpdateTags(tagsMap, cluster)
+	desired := mergeTags(r.client.DefaultTags, tagsMap)
+	if len(r.client.IgnoreTags.Keys) > 0 || len(r.client.IgnoreTags.KeyPrefixes) > 0 {
+		// UpdateTags replaces every tag, so carry over the ones managed outside Terraform.
+		stored, err := r.client.GetTags(ctx, cluster)
+		if err != nil {
+			respDiags.AddError(
+				"Unable to Read tags of Virtual Cluster with ID="+cluster.ID,
+				err.Error(),
+			)
+			return
+		}
+		desired = withIgnoredTags(desired, stored, r.client.IgnoreTags)
+	}
+
+	err := r.client.UpdateTags(ctx, desired, cluster)
 	if err != nil {
 		respDiags.AddError(
 			"Error Updating WarpStream Virtual Cluster Tags",
@@ -981,12 +1064,12 @@ func (r *virtualClusterResource) applyTags(ctx context.Context, state models.Vir
 	}
 
 	// Read updated tags
-	r.readTags(ctx, cluster, respState, respDiags)
+	r.readTags(ctx, cluster, state.Tags, respState, respDiags)
 }
 
 func (r *virtualClusterResource) readEvents(ctx context.Context, cluster api.VirtualCluster, state *tfsdk.State, respDiags *diag.Diagnostics, planEventTypes types.Map) {
 	// Get virtual cluster events state
-	eventsState, err := r.client.GetEventsState(cluster)
+	eventsState, err := r.client.GetEventsState(ctx, cluster)
 	if err != nil {
 		respDiags.AddError(
 			"Unable to Read events state of Virtual Cluster with ID="+cluster.ID,
@@ -1124,7 +1207,7 @@ func (r *virtualClusterResource) applyEvents(ctx context.Context, plan models.Vi
 	}
 
 	// Update virtual cluster events state
-	err := r.client.UpdateEventsState(enabledPtr, eventTypesMap, cluster)
+	err := r.client.UpdateEventsState(ctx, enabledPtr, eventTypesMap, cluster)
 	if err != nil {
 		respDiags.AddError(
 			"Error Updating WarpStream Virtual Cluster Events State",
@@ -22,8 +22,9 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource              = &virtualClusterCredentialsResource{}
-	_ resource.ResourceWithConfigure = &virtualClusterCredentialsResource{}
+	_ resource.Resource               = &virtualClusterCredentialsResource{}
+	_ resource.ResourceWithConfigure  = &virtualClusterCredentialsResource{}
+	_ resource.ResourceWithModifyPlan = &virtualClusterCredentialsResource{}
 )
 
 // NewVirtualClusterCredentialsResource is a helper function to simplify the provider implementation.
@@ -67,6 +68,11 @@ func (r *virtualClusterCredentialsResource) Configure(_ context.Context, req res
 		return
 	}
 
+	if err := client.RequireKeyKind("warpstream_virtual_cluster_credentials", api.KeyKindApplication, api.KeyKindAccount); err != nil {
+		resp.Diagnostics.AddError("Unsupported WarpStream API Key", err.Error())
+		return
+	}
+
 	r.client = client
 }
 
@@ -75,6 +81,39 @@ func (r *virtualClusterCredentialsResource) Metadata(_ context.Context, req reso
 	resp.TypeName = req.ProviderTypeName + "_virtual_cluster_credentials"
 }
 
+func (r *virtualClusterCredentialsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	defer warnReadOnly(r.client, req, resp)
+	// Checked once virtual_cluster_id is planned.
+	defer checkPlannedClusterWorkspace(ctx, r.client, req, resp)
+
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	// Credentials still using the deprecated `virtual_cluster` keep `virtual_cluster_id` empty.
+	var vcIDOld types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("virtual_cluster"), &vcIDOld)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !vcIDOld.IsNull() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("virtual_cluster_id"), types.StringNull())...)
+		if req.State.Raw.IsNull() {
+			return
+		}
+
+		// Moving from `virtual_cluster_id` to `virtual_cluster` still replaces the credentials.
+		var vcIDPrior types.String
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("virtual_cluster_id"), &vcIDPrior)...)
+		if !vcIDPrior.IsNull() {
+			resp.RequiresReplace = append(resp.RequiresReplace, path.Root("virtual_cluster_id"))
+		}
+		return
+	}
+
+	planVirtualClusterID(ctx, r.client, req, resp)
+}
+
 // Schema defines the schema for the resource.
 func (r *virtualClusterCredentialsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
@@ -113,18 +152,17 @@ The WarpStream provider must be authenticated with an application key to consume
 				},
 			},
 			"virtual_cluster_id": schema.StringAttribute{
-				Description: "Virtual Cluster ID. Required unless `virtual_cluster` is set.",
+				Description: "Virtual Cluster ID. Required unless `virtual_cluster` is set or the provider's `default_virtual_cluster_id` is configured.",
 				Optional:    true,
+				Computed:    true,
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 				Validators: []validator.String{
-					stringvalidator.ExactlyOneOf(
-						// The ExactlyOneOf docstring suggest that "virtual_cluster_id" is implicitly included in
-						// this array of expressions. In practice we see that the resulting error message reports
-						// just the expressions that are passed to this array explicitly. So we include both.
-						path.MatchRoot("virtual_cluster"), path.MatchRoot("virtual_cluster_id"),
-					),
+					// Leaving out both is checked in ModifyPlan, which can fall back to the provider's
+					// default_virtual_cluster_id.
+					stringvalidator.ConflictsWith(path.MatchRoot("virtual_cluster")),
 				},
 			},
 			"created_at": schema.StringAttribute{
@@ -185,7 +223,7 @@ func (r *virtualClusterCredentialsResource) Create(ctx context.Context, req reso
 	}
 
 	// Obtain virtual cluster info
-	cluster, err := r.client.GetVirtualCluster(vci)
+	cluster, err := r.client.GetVirtualCluster(ctx, vci)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading WarpStream Virtual Cluster",
@@ -201,7 +239,7 @@ func (r *virtualClusterCredentialsResource) Create(ctx context.Context, req reso
 		importedPassword = plan.Password.ValueStringPointer()
 	}
 
-	c, err := r.client.CreateCredentials(plan.Name.ValueString(), plan.ClusterSuperuser.ValueBool(), plan.ReadOnly.ValueBool(), importedPassword, *cluster)
+	c, err := r.client.CreateCredentials(ctx, plan.Name.ValueString(), plan.ClusterSuperuser.ValueBool(), plan.ReadOnly.ValueBool(), importedPassword, *cluster)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating WarpStream Virtual Cluster Credentials",
@@ -250,7 +288,7 @@ func (r *virtualClusterCredentialsResource) Read(ctx context.Context, req resour
 		return // Diagnostics handled by helper.
 	}
 
-	cluster, err := r.client.GetVirtualCluster(vci)
+	cluster, err := r.client.GetVirtualCluster(ctx, vci)
 	if err != nil {
 		if errors.Is(err, api.ErrNotFound) {
 			resp.State.RemoveResource(ctx)
@@ -264,7 +302,7 @@ func (r *virtualClusterCredentialsResource) Read(ctx context.Context, req resour
 		return
 	}
 
-	creds, err := r.client.GetCredentials(*cluster)
+	creds, err := r.client.GetCredentials(ctx, *cluster)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading WarpStream Virtual Cluster Credentials",
@@ -323,7 +361,7 @@ func (r *virtualClusterCredentialsResource) Delete(ctx context.Context, req reso
 		return // Diagnostics handled by helper.
 	}
 
-	cluster, err := r.client.GetVirtualCluster(vci)
+	cluster, err := r.client.GetVirtualCluster(ctx, vci)
 	if err != nil {
 		if errors.Is(err, api.ErrNotFound) {
 			return
@@ -337,7 +375,7 @@ func (r *virtualClusterCredentialsResource) Delete(ctx context.Context, req reso
 	}
 
 	// Delete existing credentials
-	err = r.client.DeleteCredentials(state.ID.ValueString(), *cluster)
+	err = r.client.DeleteCredentials(ctx, state.ID.ValueString(), *cluster)
 	if err != nil {
 		if errors.Is(err, api.ErrNotFound) {
 			return
@@ -367,7 +405,7 @@ func (r *virtualClusterCredentialsResource) ImportState(
 
 	var virtualClusterID *string
 
-	virtualClusters, err := r.client.GetVirtualClusters()
+	virtualClusters, err := r.client.GetVirtualClusters(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Importing WarpStream Virtual Cluster Credential",
@@ -377,7 +415,7 @@ func (r *virtualClusterCredentialsResource) ImportState(
 	}
 
 	for _, vc := range virtualClusters {
-		creds, err := r.client.GetCredentials(vc)
+		creds, err := r.client.GetCredentials(ctx, vc)
 		if err != nil {
 
 			// cluster could disappear between getting vcs and getting creds
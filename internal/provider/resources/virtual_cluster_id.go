@@ -0,0 +1,49 @@
+package resources
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/warpstreamlabs/terraform-provider-warpstream/internal/provider/api"
+)
+
+// planVirtualClusterID plans `virtual_cluster_id` as the provider's default_virtual_cluster_id when
+// the configuration leaves it out. A resource that moves to a different default is replaced, since
+// none of them can change clusters in place.
+//
+// The attribute must be Optional and Computed with UseStateForUnknown ahead of RequiresReplace, so
+// that an omitted value keeps the prior state until it is compared with the default here.
+func planVirtualClusterID(ctx context.Context, client *api.Client, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan on destroy, and no default before the provider is configured.
+	if req.Plan.Raw.IsNull() || client == nil {
+		return
+	}
+
+	attrPath := path.Root("virtual_cluster_id")
+	var configured, planned types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, attrPath, &configured)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, attrPath, &planned)...)
+	if resp.Diagnostics.HasError() || !configured.IsNull() {
+		return
+	}
+
+	if client.DefaultVirtualClusterID == "" {
+		// Without a default, an omitted value is only fine if the prior state already has one.
+		if planned.IsNull() || planned.IsUnknown() {
+			resp.Diagnostics.AddAttributeError(attrPath, "Missing Virtual Cluster ID",
+				"virtual_cluster_id must be set, or the provider's default_virtual_cluster_id configured.")
+		}
+		return
+	}
+
+	if planned.ValueString() == client.DefaultVirtualClusterID {
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, attrPath, client.DefaultVirtualClusterID)...)
+	if !req.State.Raw.IsNull() {
+		resp.RequiresReplace = append(resp.RequiresReplace, attrPath)
+	}
+}
@@ -0,0 +1,98 @@
+package resources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/require"
+
+	"github.com/warpstreamlabs/terraform-provider-warpstream/internal/provider/api"
+)
+
+// withVirtualClusterID builds a workload identity federation object whose attributes are all null
+// except `virtual_cluster_id`, which is unknown when value is tftypes.UnknownValue.
+func withVirtualClusterID(t *testing.T, value any) (tftypes.Value, resource.SchemaResponse) {
+	t.Helper()
+
+	ctx := context.Background()
+	var schemaResp resource.SchemaResponse
+	(&workloadIdentityFederationResource{}).Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	require.False(t, schemaResp.Diagnostics.HasError(), "schema: %v", schemaResp.Diagnostics)
+
+	objType, ok := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	require.True(t, ok)
+	attrs := make(map[string]tftypes.Value, len(objType.AttributeTypes))
+	for name, ty := range objType.AttributeTypes {
+		attrs[name] = tftypes.NewValue(ty, nil)
+	}
+	attrs["virtual_cluster_id"] = tftypes.NewValue(tftypes.String, value)
+	return tftypes.NewValue(objType, attrs), schemaResp
+}
+
+func TestPlanVirtualClusterID(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name            string
+		defaultID       string
+		configured      any
+		prior           any // nil for a resource being created
+		wantPlanned     string
+		wantReplace     bool
+		wantErr         bool
+		wantPlanChanged bool
+	}{
+		{name: "configured value wins", defaultID: "vci_default", configured: "vci_own", prior: "vci_own", wantPlanned: "vci_own"},
+		{name: "default on create", defaultID: "vci_default", wantPlanned: "vci_default", wantPlanChanged: true},
+		{name: "unchanged default", defaultID: "vci_default", prior: "vci_default", wantPlanned: "vci_default"},
+		{name: "changed default replaces", defaultID: "vci_new", prior: "vci_old", wantPlanned: "vci_new", wantPlanChanged: true, wantReplace: true},
+		{name: "no default on create", wantErr: true},
+		{name: "no default keeps prior state", prior: "vci_old", wantPlanned: "vci_old"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			config, schemaResp := withVirtualClusterID(t, tt.configured)
+			req := resource.ModifyPlanRequest{
+				Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: config},
+				State:  tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(config.Type(), nil)},
+			}
+			planned := tt.configured
+			if planned == nil {
+				// Terraform plans an omitted Optional+Computed attribute as unknown on create, and
+				// UseStateForUnknown carries the prior value over on update.
+				planned = tftypes.UnknownValue
+				if tt.prior != nil {
+					planned = tt.prior
+				}
+			}
+			plan, _ := withVirtualClusterID(t, planned)
+			req.Plan = tfsdk.Plan{Schema: schemaResp.Schema, Raw: plan}
+			if tt.prior != nil {
+				state, _ := withVirtualClusterID(t, tt.prior)
+				req.State.Raw = state
+			}
+			resp := &resource.ModifyPlanResponse{Plan: req.Plan}
+
+			r := &workloadIdentityFederationResource{client: &api.Client{DefaultVirtualClusterID: tt.defaultID}}
+			r.ModifyPlan(context.Background(), req, resp)
+
+			require.Equal(t, tt.wantErr, resp.Diagnostics.HasError(), resp.Diagnostics)
+			if tt.wantErr {
+				return
+			}
+			var got types.String
+			require.False(t, resp.Plan.GetAttribute(context.Background(), path.Root("virtual_cluster_id"), &got).HasError())
+			require.Equal(t, tt.wantPlanned, got.ValueString())
+			require.Equal(t, tt.wantPlanChanged, !resp.Plan.Raw.Equal(req.Plan.Raw))
+			require.Equal(t, tt.wantReplace, len(resp.RequiresReplace) > 0)
+		})
+	}
+}
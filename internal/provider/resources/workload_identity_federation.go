@@ -28,6 +28,7 @@ var (
 	_ resource.Resource                = &workloadIdentityFederationResource{}
 	_ resource.ResourceWithConfigure   = &workloadIdentityFederationResource{}
 	_ resource.ResourceWithImportState = &workloadIdentityFederationResource{}
+	_ resource.ResourceWithModifyPlan  = &workloadIdentityFederationResource{}
 )
 
 // NewWorkloadIdentityFederationResource is a helper function to simplify the provider implementation.
@@ -53,6 +54,11 @@ func (r *workloadIdentityFederationResource) Configure(_ context.Context, req re
 		return
 	}
 
+	if err := client.RequireKeyKind("warpstream_workload_identity_federation", api.KeyKindApplication, api.KeyKindAccount); err != nil {
+		resp.Diagnostics.AddError("Unsupported WarpStream API Key", err.Error())
+		return
+	}
+
 	r.client = client
 }
 
@@ -60,6 +66,13 @@ func (r *workloadIdentityFederationResource) Metadata(_ context.Context, req res
 	resp.TypeName = req.ProviderTypeName + "_workload_identity_federation"
 }
 
+func (r *workloadIdentityFederationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	defer warnReadOnly(r.client, req, resp)
+
+	planVirtualClusterID(ctx, r.client, req, resp)
+	checkPlannedClusterWorkspace(ctx, r.client, req, resp)
+}
+
 func (r *workloadIdentityFederationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: `
@@ -80,9 +93,11 @@ The WarpStream provider must be authenticated with an application key to consume
 				},
 			},
 			"virtual_cluster_id": schema.StringAttribute{
-				Description: "Virtual Cluster ID the binding grants access to. Cannot be changed after creation.",
-				Required:    true,
+				Description: "Virtual Cluster ID the binding grants access to. Cannot be changed after creation. Defaults to the provider's `default_virtual_cluster_id`.",
+				Optional:    true,
+				Computed:    true,
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 				Validators: []validator.String{utils.StartsWithAndAlphanumeric("vci_")},
@@ -167,7 +182,7 @@ func (r *workloadIdentityFederationResource) Create(ctx context.Context, req res
 		return
 	}
 
-	created, err := r.client.CreateWorkloadIdentityFederation(api.WorkloadIdentityFederation{
+	created, err := r.client.CreateWorkloadIdentityFederation(ctx, api.WorkloadIdentityFederation{
 		VirtualClusterID:        plan.VirtualClusterID.ValueString(),
 		Name:                    plan.Name.ValueString(),
 		IssuerURL:               plan.IssuerURL.ValueString(),
@@ -185,7 +200,7 @@ func (r *workloadIdentityFederationResource) Create(ctx context.Context, req res
 
 	// Re-read so state reflects the persisted values (e.g. created_at at the database's timestamp
 	// precision) rather than the create response, keeping state stable across refreshes and imports.
-	fed, err := r.client.GetWorkloadIdentityFederation(created.VirtualClusterID, created.ID)
+	fed, err := r.client.GetWorkloadIdentityFederation(ctx, created.VirtualClusterID, created.ID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading WarpStream Workload Identity Federation",
@@ -205,7 +220,7 @@ func (r *workloadIdentityFederationResource) Read(ctx context.Context, req resou
 		return
 	}
 
-	fed, err := r.client.GetWorkloadIdentityFederation(state.VirtualClusterID.ValueString(), state.ID.ValueString())
+	fed, err := r.client.GetWorkloadIdentityFederation(ctx, state.VirtualClusterID.ValueString(), state.ID.ValueString())
 	if err != nil {
 		if errors.Is(err, api.ErrNotFound) {
 			resp.State.RemoveResource(ctx)
@@ -252,7 +267,7 @@ func (r *workloadIdentityFederationResource) Delete(ctx context.Context, req res
 		return
 	}
 
-	err := r.client.DeleteWorkloadIdentityFederation(state.VirtualClusterID.ValueString(), state.ID.ValueString())
+	err := r.client.DeleteWorkloadIdentityFederation(ctx, state.VirtualClusterID.ValueString(), state.ID.ValueString())
 	if err != nil {
 		if errors.Is(err, api.ErrNotFound) {
 			return
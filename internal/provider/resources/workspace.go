@@ -22,6 +22,7 @@ var (
 	_ resource.Resource                = &workspaceResource{}
 	_ resource.ResourceWithConfigure   = &workspaceResource{}
 	_ resource.ResourceWithImportState = &workspaceResource{}
+	_ resource.ResourceWithModifyPlan  = &workspaceResource{}
 )
 
 // NewWorkspaceResource is a helper function to simplify the provider implementation.
@@ -51,6 +52,11 @@ func (r *workspaceResource) Configure(_ context.Context, req resource.ConfigureR
 		return
 	}
 
+	if err := client.RequireKeyKind("warpstream_workspace", api.KeyKindAccount); err != nil {
+		resp.Diagnostics.AddError("Unsupported WarpStream API Key", err.Error())
+		return
+	}
+
 	r.client = client
 }
 
@@ -59,6 +65,12 @@ func (r *workspaceResource) Metadata(_ context.Context, req resource.MetadataReq
 	resp.TypeName = req.ProviderTypeName + "_workspace"
 }
 
+func (r *workspaceResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	defer warnReadOnly(r.client, req, resp)
+
+	checkManagedWorkspace(ctx, r.client, req, resp)
+}
+
 // Schema defines the schema for the resource.
 func (r *workspaceResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
@@ -110,7 +122,7 @@ func (r *workspaceResource) Create(ctx context.Context, req resource.CreateReque
 	}
 
 	// Create new workspace
-	newWorkspaceID, err := r.client.CreateWorkspace(plan.Name.ValueString())
+	newWorkspaceID, err := r.client.CreateWorkspace(ctx, plan.Name.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating WarpStream Workspace",
@@ -120,7 +132,7 @@ func (r *workspaceResource) Create(ctx context.Context, req resource.CreateReque
 	}
 
 	// Describe created workspace
-	workspace, err := r.client.GetWorkspace(newWorkspaceID)
+	workspace, err := r.client.GetWorkspace(ctx, newWorkspaceID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading WarpStream Workspace",
@@ -153,7 +165,7 @@ func (r *workspaceResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
-	workspace, err := r.client.GetWorkspace(state.ID.ValueString())
+	workspace, err := r.client.GetWorkspace(ctx, state.ID.ValueString())
 	if err != nil {
 		if errors.Is(err, api.ErrNotFound) {
 			resp.State.RemoveResource(ctx)
@@ -194,7 +206,7 @@ func (r *workspaceResource) Update(ctx context.Context, req resource.UpdateReque
 
 	// Describe the workspace.
 	workspaceID := plan.ID.ValueString()
-	workspace, err := r.client.GetWorkspace(workspaceID)
+	workspace, err := r.client.GetWorkspace(ctx, workspaceID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading WarpStream Workspace",
@@ -209,7 +221,7 @@ func (r *workspaceResource) Update(ctx context.Context, req resource.UpdateReque
 	}
 
 	// Update workspace name.
-	err = r.client.RenameWorkspace(plan.ID.ValueString(), plan.Name.ValueString())
+	err = r.client.RenameWorkspace(ctx, plan.ID.ValueString(), plan.Name.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Updating WarpStream Workspace",
@@ -237,7 +249,7 @@ func (r *workspaceResource) Delete(ctx context.Context, req resource.DeleteReque
 	}
 
 	// Delete existing workspace
-	err := r.client.DeleteWorkspace(state.ID.ValueString())
+	err := r.client.DeleteWorkspace(ctx, state.ID.ValueString())
 	if err != nil {
 		if errors.Is(err, api.ErrNotFound) {
 			return
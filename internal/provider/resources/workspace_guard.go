@@ -0,0 +1,179 @@
+package resources
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/warpstreamlabs/terraform-provider-warpstream/internal/provider/api"
+	"github.com/warpstreamlabs/terraform-provider-warpstream/internal/provider/models"
+)
+
+// plannedString reads a string attribute from the plan or, when the resource is being destroyed,
+// from the prior state, so that deletes are checked as well.
+func plannedString(ctx context.Context, attrPath path.Path, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) types.String {
+	var value types.String
+	if resp.Plan.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, attrPath, &value)...)
+	} else {
+		resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, attrPath, &value)...)
+	}
+	return value
+}
+
+// checkPlannedWorkspace fails the plan when the resource's `workspace_id` is ruled out by the
+// provider's allowed_workspace_ids or forbidden_workspace_ids. A workspace that is not known yet
+// is left to Configure, which checked the API key's own workspace.
+func checkPlannedWorkspace(ctx context.Context, client *api.Client, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if client == nil || !client.GuardsWorkspaces() {
+		return
+	}
+
+	attrPath := path.Root("workspace_id")
+	workspaceID := plannedString(ctx, attrPath, req, resp)
+	if resp.Diagnostics.HasError() || workspaceID.IsNull() || workspaceID.IsUnknown() {
+		return
+	}
+
+	checkWorkspace(ctx, client, attrPath, workspaceID.ValueString(), &resp.Diagnostics)
+}
+
+// checkPlannedGrantWorkspaces is checkPlannedWorkspace for the `access_grants` of a
+// warpstream_user_role, each of which names a workspace. The billing grant's empty workspace '-'
+// is left alone, while '*' reaches every workspace and so is ruled out by either list.
+func checkPlannedGrantWorkspaces(ctx context.Context, client *api.Client, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if client == nil || !client.GuardsWorkspaces() {
+		return
+	}
+
+	attrPath := path.Root("access_grants")
+	var grantList types.List
+	if resp.Plan.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, attrPath, &grantList)...)
+	} else {
+		resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, attrPath, &grantList)...)
+	}
+	if resp.Diagnostics.HasError() || grantList.IsNull() || grantList.IsUnknown() {
+		return
+	}
+
+	var grants []models.UserRoleGrant
+	resp.Diagnostics.Append(grantList.ElementsAs(ctx, &grants, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for i, grant := range grants {
+		grantPath := attrPath.AtListIndex(i).AtName("workspace_id")
+		if grant.WorkspaceID.IsNull() || grant.WorkspaceID.IsUnknown() {
+			continue
+		}
+		switch workspaceID := grant.WorkspaceID.ValueString(); workspaceID {
+		case "-":
+		case "*":
+			resp.Diagnostics.AddAttributeError(grantPath, "Workspace Not Allowed",
+				"The provider configuration rules out granting access to every workspace ('*') "+
+					"while allowed_workspace_ids or forbidden_workspace_ids is set.")
+		default:
+			checkWorkspace(ctx, client, grantPath, workspaceID, &resp.Diagnostics)
+		}
+	}
+}
+
+// checkDefaultedWorkspace is checkPlannedWorkspace for a resource being created without a
+// `workspace_id`, which the API fills in with the default workspace of the provider.
+func checkDefaultedWorkspace(ctx context.Context, client *api.Client, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if client == nil || !client.GuardsWorkspaces() || !req.State.Raw.IsNull() || resp.Plan.Raw.IsNull() {
+		return
+	}
+
+	attrPath := path.Root("workspace_id")
+	var workspaceID types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, attrPath, &workspaceID)...)
+	if resp.Diagnostics.HasError() || !workspaceID.IsNull() {
+		return
+	}
+
+	checkWorkspace(ctx, client, attrPath, "", &resp.Diagnostics)
+}
+
+// checkManagedWorkspace fails the plan when an existing warpstream_workspace, whose `id` is the
+// workspace itself, is ruled out by the provider configuration. Workspaces being created have no
+// ID yet, and are left to Configure.
+func checkManagedWorkspace(ctx context.Context, client *api.Client, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if client == nil || !client.GuardsWorkspaces() || req.State.Raw.IsNull() {
+		return
+	}
+
+	attrPath := path.Root("id")
+	var workspaceID types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, attrPath, &workspaceID)...)
+	if resp.Diagnostics.HasError() || workspaceID.IsNull() {
+		return
+	}
+
+	checkWorkspace(ctx, client, attrPath, workspaceID.ValueString(), &resp.Diagnostics)
+}
+
+// checkWorkspace adds an error on attrPath when the provider configuration rules out workspaceID.
+// An empty workspaceID stands for the default workspace of the provider, which is looked up.
+func checkWorkspace(ctx context.Context, client *api.Client, attrPath path.Path, workspaceID string, diags *diag.Diagnostics) {
+	if client == nil || !client.GuardsWorkspaces() {
+		return
+	}
+
+	if workspaceID == "" {
+		var err error
+		workspaceID, err = client.DefaultWorkspaceID(ctx)
+		if err != nil {
+			diags.AddAttributeError(attrPath, "Unable to Check Workspace",
+				"Could not find the default workspace of the provider's API key: "+err.Error())
+			return
+		}
+		if workspaceID == "" {
+			diags.AddAttributeError(attrPath, "Unable to Check Workspace",
+				"Could not tell which workspace the provider's API key defaults to. "+
+					"Set workspace_id so that it can be checked against allowed_workspace_ids and forbidden_workspace_ids.")
+			return
+		}
+	}
+
+	if err := client.CheckWorkspaceID(workspaceID); err != nil {
+		diags.AddAttributeError(attrPath, "Workspace Not Allowed",
+			"The provider configuration rules out this resource's workspace: "+err.Error()+".")
+	}
+}
+
+// checkPlannedClusterWorkspace is checkPlannedWorkspace for cluster-scoped resources, which are in
+// the workspace of their `virtual_cluster_id`.
+func checkPlannedClusterWorkspace(ctx context.Context, client *api.Client, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if client == nil || !client.GuardsWorkspaces() {
+		return
+	}
+
+	attrPath := path.Root("virtual_cluster_id")
+	clusterID := plannedString(ctx, attrPath, req, resp)
+	if resp.Diagnostics.HasError() || clusterID.IsNull() || clusterID.IsUnknown() {
+		return
+	}
+
+	cluster, err := client.GetVirtualCluster(ctx, clusterID.ValueString())
+	if errors.Is(err, api.ErrNotFound) {
+		// Nothing to protect: the resource goes away with its cluster.
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(attrPath, "Unable to Check Virtual Cluster Workspace",
+			"Could not read WarpStream Virtual Cluster ID "+clusterID.ValueString()+": "+err.Error())
+		return
+	}
+
+	if err := client.CheckWorkspaceID(cluster.WorkspaceID); err != nil {
+		resp.Diagnostics.AddAttributeError(attrPath, "Workspace Not Allowed",
+			"The provider configuration rules out the workspace of virtual cluster "+clusterID.ValueString()+": "+err.Error()+".")
+	}
+}
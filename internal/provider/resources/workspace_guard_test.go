@@ -0,0 +1,163 @@
+package resources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/require"
+
+	"github.com/warpstreamlabs/terraform-provider-warpstream/internal/provider/api"
+)
+
+// withStringAttributes returns a value of r's schema with the given string attributes set and
+// every other attribute null.
+func withStringAttributes(t *testing.T, r resource.Resource, values map[string]string) (tftypes.Value, resource.SchemaResponse) {
+	t.Helper()
+
+	ctx := context.Background()
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	require.False(t, schemaResp.Diagnostics.HasError(), "schema: %v", schemaResp.Diagnostics)
+
+	objType, ok := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	require.True(t, ok)
+	attrs := make(map[string]tftypes.Value, len(objType.AttributeTypes))
+	for name, ty := range objType.AttributeTypes {
+		attrs[name] = tftypes.NewValue(ty, nil)
+	}
+	for name, value := range values {
+		attrs[name] = tftypes.NewValue(tftypes.String, value)
+	}
+	return tftypes.NewValue(objType, attrs), schemaResp
+}
+
+func TestCheckManagedWorkspace(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		prior     bool // false for a workspace being created
+		planned   bool // false for a workspace being destroyed
+		forbidden []string
+		wantErr   bool
+	}{
+		{name: "create", planned: true, forbidden: []string{"wi_prod"}},
+		{name: "update forbidden workspace", prior: true, planned: true, forbidden: []string{"wi_prod"}, wantErr: true},
+		{name: "destroy forbidden workspace", prior: true, forbidden: []string{"wi_prod"}, wantErr: true},
+		{name: "destroy other workspace", prior: true, forbidden: []string{"wi_other"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			r := &workspaceResource{client: &api.Client{ForbiddenWorkspaceIDs: tt.forbidden}}
+			value, schemaResp := withStringAttributes(t, r, map[string]string{"id": "wi_prod", "name": "prod"})
+			null := tftypes.NewValue(value.Type(), nil)
+			req := resource.ModifyPlanRequest{
+				Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: value},
+				Plan:   tfsdk.Plan{Schema: schemaResp.Schema, Raw: value},
+				State:  tfsdk.State{Schema: schemaResp.Schema, Raw: value},
+			}
+			if !tt.prior {
+				req.State.Raw = null
+			}
+			if !tt.planned {
+				req.Config.Raw, req.Plan.Raw = null, null
+			}
+			resp := &resource.ModifyPlanResponse{Plan: req.Plan}
+
+			r.ModifyPlan(context.Background(), req, resp)
+
+			require.Equal(t, tt.wantErr, resp.Diagnostics.HasError(), resp.Diagnostics)
+		})
+	}
+}
+
+func TestCheckDefaultedWorkspace(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		forbidden []string
+		wantErr   bool
+	}{
+		{name: "default workspace forbidden", forbidden: []string{"wi_profile"}, wantErr: true},
+		{name: "other workspace forbidden", forbidden: []string{"wi_other"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			client := &api.Client{WorkspaceID: "wi_profile", ForbiddenWorkspaceIDs: tt.forbidden}
+			r := &applicationKeyResource{client: client}
+			value, schemaResp := withStringAttributes(t, r, map[string]string{"name": "akn_test"})
+			req := resource.ModifyPlanRequest{
+				Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: value},
+				Plan:   tfsdk.Plan{Schema: schemaResp.Schema, Raw: value},
+				State:  tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(value.Type(), nil)},
+			}
+			resp := &resource.ModifyPlanResponse{Plan: req.Plan}
+
+			r.ModifyPlan(context.Background(), req, resp)
+
+			require.Equal(t, tt.wantErr, resp.Diagnostics.HasError(), resp.Diagnostics)
+		})
+	}
+}
+
+func TestCheckPlannedGrantWorkspaces(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		workspaceID string
+		allowed     []string
+		forbidden   []string
+		wantErr     bool
+	}{
+		{name: "allowed workspace", workspaceID: "wi_dev", allowed: []string{"wi_dev"}},
+		{name: "forbidden workspace", workspaceID: "wi_prod", forbidden: []string{"wi_prod"}, wantErr: true},
+		{name: "workspace not allowed", workspaceID: "wi_prod", allowed: []string{"wi_dev"}, wantErr: true},
+		{name: "every workspace", workspaceID: "*", forbidden: []string{"wi_prod"}, wantErr: true},
+		{name: "billing grant", workspaceID: "-", allowed: []string{"wi_dev"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			r := &userRoleResource{client: &api.Client{AllowedWorkspaceIDs: tt.allowed, ForbiddenWorkspaceIDs: tt.forbidden}}
+			value, schemaResp := withStringAttributes(t, r, map[string]string{"name": "role"})
+
+			// Replace the null access_grants with a single grant on tt.workspaceID.
+			var attrs map[string]tftypes.Value
+			require.NoError(t, value.As(&attrs))
+			listType, ok := attrs["access_grants"].Type().(tftypes.List)
+			require.True(t, ok)
+			grantType, ok := listType.ElementType.(tftypes.Object)
+			require.True(t, ok)
+			grant := tftypes.NewValue(grantType, map[string]tftypes.Value{
+				"workspace_id": tftypes.NewValue(tftypes.String, tt.workspaceID),
+				"grant_type":   tftypes.NewValue(tftypes.String, "admin"),
+			})
+			attrs["access_grants"] = tftypes.NewValue(listType, []tftypes.Value{grant})
+			value = tftypes.NewValue(value.Type(), attrs)
+
+			req := resource.ModifyPlanRequest{
+				Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: value},
+				Plan:   tfsdk.Plan{Schema: schemaResp.Schema, Raw: value},
+				State:  tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(value.Type(), nil)},
+			}
+			resp := &resource.ModifyPlanResponse{Plan: req.Plan}
+
+			r.ModifyPlan(context.Background(), req, resp)
+
+			require.Equal(t, tt.wantErr, resp.Diagnostics.HasError(), resp.Diagnostics)
+		})
+	}
+}
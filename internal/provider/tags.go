@@ -0,0 +1,81 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/warpstreamlabs/terraform-provider-warpstream/internal/provider/api"
+)
+
+// defaultTagsModel describes the default_tags block.
+type defaultTagsModel struct {
+	Tags types.Map `tfsdk:"tags"`
+}
+
+// defaultTags returns the tags set in the default_tags block, or nil when there are none.
+func defaultTags(ctx context.Context, config warpstreamProviderModel, diags *diag.Diagnostics) map[string]string {
+	if config.DefaultTags.IsNull() {
+		return nil
+	}
+
+	var block defaultTagsModel
+	diags.Append(config.DefaultTags.As(ctx, &block, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() || block.Tags.IsNull() {
+		return nil
+	}
+	if block.Tags.IsUnknown() {
+		diags.AddAttributeError(path.Root("default_tags").AtName("tags"), "Unknown Default Tags",
+			"The provider cannot apply default tags as there is an unknown configuration value for default_tags. "+
+				"Either target apply the source of the value first, or set the value statically in the configuration.")
+		return nil
+	}
+
+	var tags map[string]string
+	diags.Append(block.Tags.ElementsAs(ctx, &tags, false)...)
+	return tags
+}
+
+// ignoreTagsModel describes the ignore_tags block.
+type ignoreTagsModel struct {
+	Keys        types.Set `tfsdk:"keys"`
+	KeyPrefixes types.Set `tfsdk:"key_prefixes"`
+}
+
+// ignoreTags returns the keys and key prefixes set in the ignore_tags block.
+func ignoreTags(ctx context.Context, config warpstreamProviderModel, diags *diag.Diagnostics) api.IgnoreTags {
+	if config.IgnoreTags.IsNull() {
+		return api.IgnoreTags{}
+	}
+
+	var block ignoreTagsModel
+	diags.Append(config.IgnoreTags.As(ctx, &block, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return api.IgnoreTags{}
+	}
+
+	var ignore api.IgnoreTags
+	for _, attr := range []struct {
+		name string
+		set  types.Set
+	}{{"keys", block.Keys}, {"key_prefixes", block.KeyPrefixes}} {
+		if attr.set.IsUnknown() {
+			diags.AddAttributeError(path.Root("ignore_tags").AtName(attr.name), "Unknown Ignored Tags",
+				"The provider cannot ignore tags as there is an unknown configuration value for ignore_tags. "+
+					"Either target apply the source of the value first, or set the value statically in the configuration.")
+		}
+	}
+	if diags.HasError() {
+		return api.IgnoreTags{}
+	}
+	if !block.Keys.IsNull() {
+		diags.Append(block.Keys.ElementsAs(ctx, &ignore.Keys, false)...)
+	}
+	if !block.KeyPrefixes.IsNull() {
+		diags.Append(block.KeyPrefixes.ElementsAs(ctx, &ignore.KeyPrefixes, false)...)
+	}
+	return ignore
+}
@@ -101,10 +101,10 @@ func TestAccACLResourceDeletePlan(t *testing.T) {
 					client, err := api.NewClientDefault()
 					require.NoError(t, err)
 
-					vc, err := client.FindVirtualCluster(vcName)
+					vc, err := client.FindVirtualCluster(t.Context(), vcName)
 					require.NoError(t, err)
 
-					acls, err := client.ListACLs(vc.ID)
+					acls, err := client.ListACLs(t.Context(), vc.ID)
 					require.NoError(t, err)
 
 					var aclToDelete string
@@ -116,7 +116,7 @@ func TestAccACLResourceDeletePlan(t *testing.T) {
 					}
 					require.NotEmpty(t, aclToDelete)
 
-					err = client.DeleteACL(vc.ID, api.ACLRequest{
+					err = client.DeleteACL(t.Context(), vc.ID, api.ACLRequest{
 						ResourceType:   "TOPIC",
 						ResourceName:   "orders",
 						PatternType:    "LITERAL",
@@ -0,0 +1,103 @@
+package tests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/stretchr/testify/require"
+	"github.com/warpstreamlabs/terraform-provider-warpstream/internal/provider/api"
+)
+
+func TestAccACLsResourceExclusive(t *testing.T) {
+	vcName := "vcn_acls_" + testRandString(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProviderFactories(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccACLsResource(vcName),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("warpstream_acls.test", tfjsonpath.New("acls"), knownvalue.SetSizeExact(1)),
+				},
+			},
+			// An ACL created outside Terraform shows up in the plan, and is deleted.
+			{
+				PreConfig: func() {
+					client, err := newTestAPIClient(t)
+					require.NoError(t, err)
+
+					vc, err := client.FindVirtualCluster(t.Context(), vcName)
+					require.NoError(t, err)
+
+					_, err = client.CreateACL(t.Context(), vc.ID, api.ACLRequest{
+						ResourceType:   "TOPIC",
+						ResourceName:   "orders",
+						PatternType:    "LITERAL",
+						Principal:      "User:mallory",
+						Host:           "*",
+						Operation:      "READ",
+						PermissionType: "ALLOW",
+					})
+					require.NoError(t, err)
+				},
+				Config: testAccACLsResource(vcName),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("warpstream_acls.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("warpstream_acls.test", tfjsonpath.New("acls"), knownvalue.SetSizeExact(1)),
+				},
+			},
+			// No OP Change
+			{
+				Config: testAccACLsResource(vcName),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			{
+				ResourceName:      "warpstream_acls.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// Imports are never exclusive.
+				ImportStateVerifyIgnore: []string{"exclusive"},
+			},
+		},
+	})
+}
+
+func testAccACLsResource(vcName string) string {
+	return providerConfig + fmt.Sprintf(`
+resource "warpstream_virtual_cluster" "acl_vc" {
+  name = "%s"
+  tier = "dev"
+  configuration = {
+    enable_acls = true
+  }
+}
+
+resource "warpstream_acls" "test" {
+  virtual_cluster_id = warpstream_virtual_cluster.acl_vc.id
+  exclusive          = true
+
+  acls = [{
+    host            = "*"
+    principal       = "User:alice"
+    operation       = "READ"
+    permission_type = "ALLOW"
+    resource_type   = "TOPIC"
+    resource_name   = "orders"
+    pattern_type    = "LITERAL"
+  }]
+}
+`, vcName)
+}
@@ -27,7 +27,7 @@ func TestAccAgentKeyResourceDeletePlan(t *testing.T) {
 					client, err := api.NewClientDefault()
 					require.NoError(t, err)
 
-					apiKeys, err := client.GetAPIKeys()
+					apiKeys, err := client.GetAPIKeys(t.Context())
 					require.NoError(t, err)
 
 					var apiKeyID string
@@ -39,7 +39,7 @@ func TestAccAgentKeyResourceDeletePlan(t *testing.T) {
 					}
 					require.NotEmpty(t, apiKeyID)
 
-					err = client.DeleteAPIKey(apiKeyID)
+					err = client.DeleteAPIKey(t.Context(), apiKeyID)
 					require.NoError(t, err)
 				},
 				PlanOnly:           true,
@@ -77,6 +77,7 @@ func TestAccAgentKeyResourceSchemaRegistryCluster(t *testing.T) {
 	vcNameSuffix := acctest.RandStringFromCharSet(6, acctest.CharSetAlphaNum)
 	region := "us-east-1"
 	vc, err := client.CreateVirtualCluster(
+		t.Context(),
 		testClusterName(vcNameSuffix),
 		api.ClusterParameters{
 			Type:   api.VirtualClusterTypeSchemaRegistry,
@@ -87,7 +88,7 @@ func TestAccAgentKeyResourceSchemaRegistryCluster(t *testing.T) {
 	)
 	require.NoError(t, err)
 	defer func() {
-		err := client.DeleteVirtualCluster(vc.ID, vc.Name)
+		err := client.DeleteVirtualCluster(t.Context(), vc.ID, vc.Name)
 		if err != nil {
 			panic(fmt.Errorf("failed to delete virtual cluster: %w", err))
 		}
@@ -113,6 +114,7 @@ func TestAccAgentKeyResourceTableFlowCluster(t *testing.T) {
 	vcNameSuffix := acctest.RandStringFromCharSet(6, acctest.CharSetAlphaNum)
 	region := "us-east-1"
 	vc, err := client.CreateVirtualCluster(
+		t.Context(),
 		testClusterName(vcNameSuffix),
 		api.ClusterParameters{
 			Type:   api.VirtualClusterTypeTableFlow,
@@ -123,7 +125,7 @@ func TestAccAgentKeyResourceTableFlowCluster(t *testing.T) {
 	)
 	require.NoError(t, err)
 	defer func() {
-		err := client.DeleteVirtualCluster(vc.ID, vc.Name)
+		err := client.DeleteVirtualCluster(t.Context(), vc.ID, vc.Name)
 		if err != nil {
 			panic(fmt.Errorf("failed to delete virtual cluster: %w", err))
 		}
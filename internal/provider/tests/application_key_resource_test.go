@@ -27,7 +27,7 @@ func TestAccApplicationKeyResourceDeletePLan(t *testing.T) {
 					client, err := api.NewClientDefault()
 					require.NoError(t, err)
 
-					apiKeys, err := client.GetAPIKeys()
+					apiKeys, err := client.GetAPIKeys(t.Context())
 					require.NoError(t, err)
 
 					var apiKeyID string
@@ -39,7 +39,7 @@ func TestAccApplicationKeyResourceDeletePLan(t *testing.T) {
 					}
 					require.NotEmpty(t, apiKeyID)
 
-					err = client.DeleteAPIKey(apiKeyID)
+					err = client.DeleteAPIKey(t.Context(), apiKeyID)
 					require.NoError(t, err)
 				},
 				PlanOnly:           true,
@@ -97,7 +97,7 @@ func TestAccAccountKeyApplicationKeyResourceWithWorkspaceID(t *testing.T) {
 	keyName1, keyName2 := "akn_test_application_key"+nameSuffix+"_1", "akn_test_application_key"+nameSuffix+"_2"
 	client, err := api.NewClientDefault()
 	require.NoError(t, err)
-	workspaces, err := client.GetWorkspaces()
+	workspaces, err := client.GetWorkspaces(t.Context())
 	require.NoError(t, err)
 	require.Greater(t, len(workspaces), 1, "Are you running this test with an account key?") // Get at least two workspaces.
 
@@ -247,7 +247,7 @@ func testAccApplicationKeyResourceCheckWithReadOnly(resourceName, keyName, works
 func getWorkspacesNotEmpty(t *testing.T) []api.Workspace {
 	client, err := api.NewClientDefault()
 	require.NoError(t, err)
-	workspaces, err := client.GetWorkspaces()
+	workspaces, err := client.GetWorkspaces(t.Context())
 	require.NoError(t, err)
 	require.NotEmpty(t, workspaces)
 	return workspaces
@@ -0,0 +1,143 @@
+package tests
+
+import (
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/warpstreamlabs/terraform-provider-warpstream/internal/provider"
+	"github.com/warpstreamlabs/terraform-provider-warpstream/internal/provider/api"
+	"github.com/warpstreamlabs/terraform-provider-warpstream/internal/provider/apitest/cassette"
+)
+
+const (
+	// cassetteEnv turns on cassettes for the tests that use them: "record" captures the API
+	// traffic of a run against the selected backend into testdata/cassettes, and "replay" serves
+	// it back without touching the network. When it is unset, cassettes are off.
+	cassetteEnv = "WARPSTREAM_ACC_CASSETTE"
+
+	cassetteRecord = "record"
+	cassetteReplay = "replay"
+
+	cassetteDir = "testdata/cassettes"
+
+	// cassetteNameSuffix replaces the random nameSuffix under cassettes, so that names match
+	// between recording and replay.
+	cassetteNameSuffix = "tape00"
+)
+
+var (
+	cassettes sync.Map // test name -> *cassette.Cassette
+
+	randStringMu    sync.Mutex
+	randStringCalls = map[string]int{}
+)
+
+func cassetteMode() (string, error) {
+	switch mode := os.Getenv(cassetteEnv); mode {
+	case "", cassetteRecord, cassetteReplay:
+		return mode, nil
+	default:
+		return "", fmt.Errorf("%s must be %q or %q, got %q", cassetteEnv, cassetteRecord, cassetteReplay, mode)
+	}
+}
+
+// cassetteFor returns the cassette for the running test, recording or replaying according to
+// cassetteEnv, or nil when cassettes are off. The provider and any direct API clients in a test
+// share one cassette.
+func cassetteFor(t *testing.T) *cassette.Cassette {
+	t.Helper()
+
+	mode, err := cassetteMode()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if mode == "" {
+		return nil
+	}
+	if c, ok := cassettes.Load(t.Name()); ok {
+		return c.(*cassette.Cassette)
+	}
+
+	path := filepath.Join(cassetteDir, strings.ReplaceAll(t.Name(), "/", "_")+".json")
+	var c *cassette.Cassette
+	if mode == cassetteReplay {
+		c, err = cassette.Load(path)
+		if errors.Is(err, fs.ErrNotExist) {
+			t.Fatalf("no cassette at %s; record one with %s=%s", path, cassetteEnv, cassetteRecord)
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+	} else {
+		c = cassette.NewRecorder()
+		t.Cleanup(func() {
+			// A failed run would check in traffic that doesn't pass.
+			if t.Failed() {
+				return
+			}
+			if err := c.Save(path); err != nil {
+				t.Errorf("saving cassette: %v", err)
+			}
+		})
+	}
+	cassettes.Store(t.Name(), c)
+	return c
+}
+
+// testAccProviderFactories is testAccProtoV6ProviderFactories with the provider's API traffic
+// going through the test's cassette, if there is one.
+func testAccProviderFactories(t *testing.T) map[string]func() (tfprotov6.ProviderServer, error) {
+	t.Helper()
+
+	c := cassetteFor(t)
+	if c == nil {
+		return testAccProtoV6ProviderFactories
+	}
+	return map[string]func() (tfprotov6.ProviderServer, error){
+		"warpstream": providerserver.NewProtocol6WithError(provider.New("test", provider.WithTransport(c.WrapTransport))()),
+	}
+}
+
+// newTestAPIClient is api.NewClientDefault with its traffic going through the test's cassette, if
+// there is one.
+func newTestAPIClient(t *testing.T) (*api.Client, error) {
+	t.Helper()
+
+	opts := api.DefaultClientOptions()
+	if c := cassetteFor(t); c != nil {
+		opts.WrapTransport = c.WrapTransport
+	}
+	token := os.Getenv("WARPSTREAM_API_KEY")
+	return api.NewClient(os.Getenv("WARPSTREAM_API_URL"), &token, "test", opts)
+}
+
+// testRandString returns a random six character suffix for resource names. Under a cassette the
+// suffix is derived from the test name instead, so that replayed requests match recorded ones.
+func testRandString(t *testing.T) string {
+	t.Helper()
+
+	if cassetteFor(t) == nil {
+		return acctest.RandStringFromCharSet(6, acctest.CharSetAlphaNum)
+	}
+
+	randStringMu.Lock()
+	randStringCalls[t.Name()]++
+	n := randStringCalls[t.Name()]
+	randStringMu.Unlock()
+
+	h := fnv.New64a()
+	_, _ = fmt.Fprintf(h, "%s/%d", t.Name(), n)
+	s := strconv.FormatUint(h.Sum64(), 36)
+	return strings.Repeat("0", max(0, 6-len(s))) + s[:min(6, len(s))]
+}
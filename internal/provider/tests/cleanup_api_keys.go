@@ -15,7 +15,7 @@ func cleanupAPIKeyByName(t *testing.T, name string) {
 		return
 	}
 
-	apiKeys, err := client.GetAPIKeys()
+	apiKeys, err := client.GetAPIKeys(t.Context())
 	if err != nil {
 		t.Errorf("cleanup: failed to list api keys: %v", err)
 		return
@@ -25,7 +25,7 @@ func cleanupAPIKeyByName(t *testing.T, name string) {
 		if apiKey.Name != name {
 			continue
 		}
-		if err := client.DeleteAPIKey(apiKey.ID); err != nil {
+		if err := client.DeleteAPIKey(t.Context(), apiKey.ID); err != nil {
 			t.Errorf("cleanup: failed to delete api key %q (%s): %v", name, apiKey.ID, err)
 		}
 		return
@@ -245,10 +245,10 @@ func TestAccClientMetricsSubscriptionResource_DriftRecreate(t *testing.T) {
 					client, err := api.NewClientDefault()
 					require.NoError(t, err)
 
-					vc, err := client.FindVirtualCluster(vcName)
+					vc, err := client.FindVirtualCluster(t.Context(), vcName)
 					require.NoError(t, err)
 
-					require.NoError(t, client.DeleteClientMetricsSubscriptions(vc.ID, []string{"producers"}))
+					require.NoError(t, client.DeleteClientMetricsSubscriptions(t.Context(), vc.ID, []string{"producers"}))
 				},
 				PlanOnly:           true,
 				ExpectNonEmptyPlan: true,
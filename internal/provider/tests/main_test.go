@@ -0,0 +1,99 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/warpstreamlabs/terraform-provider-warpstream/internal/provider/api"
+	"github.com/warpstreamlabs/terraform-provider-warpstream/internal/provider/apitest/fakeserver"
+)
+
+const (
+	// backendEnv selects what the acceptance tests run against: "fake" for an in-memory control
+	// plane started by TestMain, or "live" for the API named by WARPSTREAM_API_URL, which defaults
+	// to production. When it is unset, the tests run live if WARPSTREAM_API_URL,
+	// WARPSTREAM_API_KEY or WARPSTREAM_PROFILE is set and against the fake otherwise.
+	backendEnv = "WARPSTREAM_ACC_BACKEND"
+
+	backendFake = "fake"
+	backendLive = "live"
+
+	// fakeAPIKey is the token the tests present to the fake, which accepts any non-empty key.
+	fakeAPIKey = "aks_acceptance_test_fake_key"
+
+	fakeSecondWorkspaceName = "acceptance_test_second"
+)
+
+func TestMain(m *testing.M) {
+	backend, err := selectBackend()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	if mode, err := cassetteMode(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	} else if mode != "" {
+		nameSuffix = cassetteNameSuffix
+	}
+
+	if backend == backendLive {
+		os.Exit(m.Run())
+	}
+
+	server, err := startFakeBackend()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	code := m.Run()
+	server.Close()
+	os.Exit(code)
+}
+
+func selectBackend() (string, error) {
+	switch backend := os.Getenv(backendEnv); backend {
+	case backendFake, backendLive:
+		return backend, nil
+	case "":
+		if os.Getenv("WARPSTREAM_API_URL") != "" || os.Getenv("WARPSTREAM_API_KEY") != "" || os.Getenv("WARPSTREAM_PROFILE") != "" {
+			return backendLive, nil
+		}
+		return backendFake, nil
+	default:
+		return "", fmt.Errorf("%s must be %q or %q, got %q", backendEnv, backendFake, backendLive, backend)
+	}
+}
+
+// startFakeBackend starts the fake control plane and seeds it to look like the accounts the live
+// runs use.
+func startFakeBackend() (*fakeserver.Server, error) {
+	server := fakeserver.New()
+
+	// Both the provider and api.NewClientDefault read their endpoint and token from the
+	// environment, so pointing the environment at the fake redirects every test without touching
+	// providerConfig. A profile would take precedence over both, so it is cleared.
+	for key, value := range map[string]string{
+		"WARPSTREAM_API_URL": server.URL(),
+		"WARPSTREAM_API_KEY": fakeAPIKey,
+		"WARPSTREAM_PROFILE": "",
+	} {
+		if err := os.Setenv(key, value); err != nil {
+			server.Close()
+			return nil, err
+		}
+	}
+
+	// The account-key tests need a workspace besides the default one.
+	client, err := api.NewClientDefault()
+	if err == nil {
+		_, err = client.CreateWorkspace(context.Background(), fakeSecondWorkspaceName)
+	}
+	if err != nil {
+		server.Close()
+		return nil, fmt.Errorf("seeding fake control plane: %w", err)
+	}
+	return server, nil
+}
@@ -29,7 +29,7 @@ func TestBentoPipelineResourceDeletePlan(t *testing.T) {
 					client, err := api.NewClientDefault()
 					require.NoError(t, err)
 
-					vcs, err := client.GetVirtualClusters()
+					vcs, err := client.GetVirtualClusters(t.Context())
 					require.NoError(t, err)
 
 					var virtualCluster api.VirtualCluster
@@ -73,7 +73,7 @@ func TestBentoPipelineResourceDeletePlan(t *testing.T) {
 					client, err := api.NewClientDefault()
 					require.NoError(t, err)
 
-					vcs, err := client.GetVirtualClusters()
+					vcs, err := client.GetVirtualClusters(t.Context())
 					require.NoError(t, err)
 
 					var virtualCluster api.VirtualCluster
@@ -85,7 +85,7 @@ func TestBentoPipelineResourceDeletePlan(t *testing.T) {
 					}
 					require.NotEmpty(t, virtualCluster.ID)
 
-					err = client.DeleteVirtualCluster(virtualCluster.ID, virtualCluster.Name)
+					err = client.DeleteVirtualCluster(t.Context(), virtualCluster.ID, virtualCluster.Name)
 					require.NoError(t, err)
 				},
 				PlanOnly:           true,
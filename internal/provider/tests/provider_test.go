@@ -10,7 +10,9 @@ import (
 const (
 	// providerConfig is a shared configuration to combine with the actual
 	// test configuration so the WarpStream client is properly configured.
-	// WARPSTREAM_API_KEY must be set in .github/workflows/test.yml.
+	// The client reads WARPSTREAM_API_URL and WARPSTREAM_API_KEY, which
+	// .github/workflows/test.yml sets for live runs and TestMain points at
+	// the fake control plane otherwise.
 	providerConfig = `
 provider "warpstream" {
   # base_url = "${WARPSTREAM_API_URL}"
@@ -19,6 +19,7 @@ func TestAccSchemaRegistryDataSource(t *testing.T) {
 	region := "us-east-1"
 	tier := api.VirtualClusterTierFundamentals
 	vc, err := client.CreateVirtualCluster(
+		t.Context(),
 		testClusterName(vcNameSuffix),
 		api.ClusterParameters{
 			Type:   api.VirtualClusterTypeSchemaRegistry,
@@ -29,7 +30,7 @@ func TestAccSchemaRegistryDataSource(t *testing.T) {
 	)
 	require.NoError(t, err)
 	defer func() {
-		err := client.DeleteVirtualCluster(vc.ID, vc.Name)
+		err := client.DeleteVirtualCluster(t.Context(), vc.ID, vc.Name)
 		if err != nil {
 			panic(fmt.Errorf("failed to delete virtual cluster: %w", err))
 		}
@@ -109,6 +110,7 @@ func TestAccSchemaRegistryDatasource_BYOCNotWork(t *testing.T) {
 	vcNameSuffix := acctest.RandStringFromCharSet(6, acctest.CharSetAlphaNum)
 	region := "us-east-1"
 	vc, err := client.CreateVirtualCluster(
+		t.Context(),
 		testClusterName(vcNameSuffix),
 		api.ClusterParameters{
 			Type:   api.VirtualClusterTypeBYOC,
@@ -119,7 +121,7 @@ func TestAccSchemaRegistryDatasource_BYOCNotWork(t *testing.T) {
 	)
 	require.NoError(t, err)
 	defer func() {
-		err := client.DeleteVirtualCluster(vc.ID, vc.Name)
+		err := client.DeleteVirtualCluster(t.Context(), vc.ID, vc.Name)
 		if err != nil {
 			panic(fmt.Errorf("failed to delete virtual cluster: %w", err))
 		}
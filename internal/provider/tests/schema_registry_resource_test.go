@@ -35,10 +35,10 @@ func TestAccSchemaRegistryResourceDeletePlan(t *testing.T) {
 					client, err := api.NewClientDefault()
 					require.NoError(t, err)
 
-					virtualCluster, err := client.FindVirtualCluster(fmt.Sprintf("vcn_sr_test_%s", vcNameSuffix))
+					virtualCluster, err := client.FindVirtualCluster(t.Context(), fmt.Sprintf("vcn_sr_test_%s", vcNameSuffix))
 					require.NoError(t, err)
 
-					err = client.DeleteVirtualCluster(virtualCluster.ID, virtualCluster.Name)
+					err = client.DeleteVirtualCluster(t.Context(), virtualCluster.ID, virtualCluster.Name)
 					require.NoError(t, err)
 				},
 				PlanOnly:           true,
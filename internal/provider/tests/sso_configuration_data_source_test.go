@@ -14,17 +14,17 @@ func TestAccAccountKeySequentialSSOConfigurationDataSource(t *testing.T) {
 	client, err := api.NewClientDefault()
 	require.NoError(t, err)
 
-	userRole, err := client.FindUserRole("Admin")
+	userRole, err := client.FindUserRole(t.Context(), "Admin")
 	require.NoError(t, err)
 
-	ssoConfig, err := client.GetSSOConfigurationWithoutID()
+	ssoConfig, err := client.GetSSOConfigurationWithoutID(t.Context())
 	require.NoError(t, err)
 	if ssoConfig != nil {
-		err = client.DeleteSSOConfiguration(ssoConfig.ID)
+		err = client.DeleteSSOConfiguration(t.Context(), ssoConfig.ID)
 		require.NoError(t, err)
 	}
 
-	id, err := client.CreateSSOConfiguration(api.SSOConfigurationCreateRequest{
+	id, err := client.CreateSSOConfiguration(t.Context(), api.SSOConfigurationCreateRequest{
 		EntityID:           "test-entity-id",
 		SAMLURL:            "https://example.com/saml",
 		DefaultRoleID:      userRole.ID,
@@ -34,7 +34,7 @@ func TestAccAccountKeySequentialSSOConfigurationDataSource(t *testing.T) {
 	require.NoError(t, err)
 
 	defer func() {
-		err = client.DeleteSSOConfiguration(id)
+		err = client.DeleteSSOConfiguration(t.Context(), id)
 		require.NoError(t, err)
 	}()
 
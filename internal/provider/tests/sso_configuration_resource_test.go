@@ -29,14 +29,14 @@ func TestAccAccountKeySequentialSSOConfigurationResource(t *testing.T) {
 	ssoIdentifierSuffix := acctest.RandStringFromCharSet(6, acctest.CharSetAlphaNum)
 	client, err := api.NewClientDefault()
 	require.NoError(t, err)
-	ssoConfig, err := client.GetSSOConfigurationWithoutID()
+	ssoConfig, err := client.GetSSOConfigurationWithoutID(t.Context())
 	require.NoError(t, err)
 	if ssoConfig != nil {
-		err = client.DeleteSSOConfiguration(ssoConfig.ID)
+		err = client.DeleteSSOConfiguration(t.Context(), ssoConfig.ID)
 		require.NoError(t, err)
 	}
 
-	userRole, err := client.FindUserRole("Admin")
+	userRole, err := client.FindUserRole(t.Context(), "Admin")
 	require.NoError(t, err)
 
 	resource.Test(t, resource.TestCase{
@@ -64,14 +64,14 @@ func TestAccAccountKeySequentialSSOConfigurationImport(t *testing.T) {
 	ssoIdentifierSuffix := acctest.RandStringFromCharSet(6, acctest.CharSetAlphaNum)
 	client, err := api.NewClientDefault()
 	require.NoError(t, err)
-	ssoConfig, err := client.GetSSOConfigurationWithoutID()
+	ssoConfig, err := client.GetSSOConfigurationWithoutID(t.Context())
 	require.NoError(t, err)
 	if ssoConfig != nil {
-		err = client.DeleteSSOConfiguration(ssoConfig.ID)
+		err = client.DeleteSSOConfiguration(t.Context(), ssoConfig.ID)
 		require.NoError(t, err)
 	}
 
-	userRole, err := client.FindUserRole("Admin")
+	userRole, err := client.FindUserRole(t.Context(), "Admin")
 	require.NoError(t, err)
 
 	resource.Test(t, resource.TestCase{
@@ -18,6 +18,7 @@ func TestAccTableFlowDataSource(t *testing.T) {
 	vcNameSuffix := acctest.RandStringFromCharSet(6, acctest.CharSetAlphaNum)
 	region := "us-east-1"
 	vc, err := client.CreateVirtualCluster(
+		t.Context(),
 		testClusterName(vcNameSuffix),
 		api.ClusterParameters{
 			Type:   api.VirtualClusterTypeTableFlow,
@@ -28,7 +29,7 @@ func TestAccTableFlowDataSource(t *testing.T) {
 	)
 	require.NoError(t, err)
 	defer func() {
-		err := client.DeleteVirtualCluster(vc.ID, vc.Name)
+		err := client.DeleteVirtualCluster(t.Context(), vc.ID, vc.Name)
 		if err != nil {
 			panic(fmt.Errorf("failed to delete virtual cluster: %w", err))
 		}
@@ -105,6 +106,7 @@ func TestAccTableFlowDatasource_BYOCNotWork(t *testing.T) {
 	vcNameSuffix := acctest.RandStringFromCharSet(6, acctest.CharSetAlphaNum)
 	region := "us-east-1"
 	vc, err := client.CreateVirtualCluster(
+		t.Context(),
 		testClusterName(vcNameSuffix),
 		api.ClusterParameters{
 			Type:   api.VirtualClusterTypeBYOC,
@@ -115,7 +117,7 @@ func TestAccTableFlowDatasource_BYOCNotWork(t *testing.T) {
 	)
 	require.NoError(t, err)
 	defer func() {
-		err := client.DeleteVirtualCluster(vc.ID, vc.Name)
+		err := client.DeleteVirtualCluster(t.Context(), vc.ID, vc.Name)
 		if err != nil {
 			panic(fmt.Errorf("failed to delete virtual cluster: %w", err))
 		}
@@ -5,21 +5,19 @@ import (
 	"regexp"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
 	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
 	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
 	"github.com/stretchr/testify/require"
-	"github.com/warpstreamlabs/terraform-provider-warpstream/internal/provider/api"
 	"github.com/warpstreamlabs/terraform-provider-warpstream/internal/provider/utils"
 )
 
 func TestAccTopicResourceMultipleConfigs(t *testing.T) {
-	var cluster = acctest.RandStringFromCharSet(6, acctest.CharSetAlphaNum)
+	var cluster = testRandString(t)
 	resource.Test(t, resource.TestCase{
-		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: testAccProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccTopicAndClusterResource(cluster),
@@ -299,9 +297,9 @@ resource "warpstream_topic" "topic" {
 }
 
 func TestAccTopicResourceTopicTypeConfig(t *testing.T) {
-	var cluster = acctest.RandStringFromCharSet(6, acctest.CharSetAlphaNum)
+	var cluster = testRandString(t)
 	resource.Test(t, resource.TestCase{
-		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: testAccProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccTopicAndClusterResource(cluster),
@@ -383,10 +381,10 @@ resource "warpstream_topic" "topic" {
 }
 
 func TestAccTopicResourceDeletePlan(t *testing.T) {
-	virtualClusterRandString := acctest.RandStringFromCharSet(6, acctest.CharSetAlphaNum)
+	virtualClusterRandString := testRandString(t)
 	virtualClusterName := fmt.Sprintf("vcn_test_acc_%s", virtualClusterRandString)
 	resource.Test(t, resource.TestCase{
-		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: testAccProviderFactories(t),
 		Steps: []resource.TestStep{
 			// Create topic
 			{
@@ -403,13 +401,13 @@ func TestAccTopicResourceDeletePlan(t *testing.T) {
 			// Pre delete topic and try planning
 			{
 				PreConfig: func() {
-					client, err := api.NewClientDefault()
+					client, err := newTestAPIClient(t)
 					require.NoError(t, err)
 
-					virtualCluster, err := client.FindVirtualCluster(virtualClusterName)
+					virtualCluster, err := client.FindVirtualCluster(t.Context(), virtualClusterName)
 					require.NoError(t, err)
 
-					err = client.DeleteTopic(virtualCluster.ID, "test")
+					err = client.DeleteTopic(t.Context(), virtualCluster.ID, "test")
 					require.NoError(t, err)
 				},
 				PlanOnly:           true,
@@ -436,13 +434,13 @@ func TestAccTopicResourceDeletePlan(t *testing.T) {
 			// Delete virtual cluster and try planning
 			{
 				PreConfig: func() {
-					client, err := api.NewClientDefault()
+					client, err := newTestAPIClient(t)
 					require.NoError(t, err)
 
-					virtualCluster, err := client.FindVirtualCluster(virtualClusterName)
+					virtualCluster, err := client.FindVirtualCluster(t.Context(), virtualClusterName)
 					require.NoError(t, err)
 
-					err = client.DeleteVirtualCluster(virtualCluster.ID, virtualCluster.Name)
+					err = client.DeleteVirtualCluster(t.Context(), virtualCluster.ID, virtualCluster.Name)
 					require.NoError(t, err)
 				},
 				PlanOnly:           true,
@@ -460,9 +458,9 @@ func TestAccTopicResourceDeletePlan(t *testing.T) {
 }
 
 func TestAccTopicResource(t *testing.T) {
-	var cluster = acctest.RandStringFromCharSet(6, acctest.CharSetAlphaNum)
+	var cluster = testRandString(t)
 	resource.Test(t, resource.TestCase{
-		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: testAccProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccTopicAndClusterResource(cluster),
@@ -594,9 +592,9 @@ resource "warpstream_topic" "topic" {
 }
 
 func TestAccTopicResourceCleanupPolicyTransitionFromDelete(t *testing.T) {
-	var cluster = acctest.RandStringFromCharSet(6, acctest.CharSetAlphaNum)
+	var cluster = testRandString(t)
 	resource.Test(t, resource.TestCase{
-		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: testAccProviderFactories(t),
 		Steps: []resource.TestStep{
 			// Step 1: Create topic with cleanup.policy = "delete"
 			{
@@ -630,9 +628,9 @@ func TestAccTopicResourceCleanupPolicyTransitionFromDelete(t *testing.T) {
 }
 
 func TestAccTopicResourceCleanupPolicyTransitionFromCompact(t *testing.T) {
-	var cluster = acctest.RandStringFromCharSet(6, acctest.CharSetAlphaNum)
+	var cluster = testRandString(t)
 	resource.Test(t, resource.TestCase{
-		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: testAccProviderFactories(t),
 		Steps: []resource.TestStep{
 			// Step 1: Create topic with cleanup.policy = "compact"
 			{
@@ -666,10 +664,10 @@ func TestAccTopicResourceCleanupPolicyTransitionFromCompact(t *testing.T) {
 
 func TestAccTopicImport(t *testing.T) {
 	resource.Test(t, resource.TestCase{
-		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: testAccProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
-				Config: testAccTopicAndClusterResource(acctest.RandStringFromCharSet(6, acctest.CharSetAlphaNum)),
+				Config: testAccTopicAndClusterResource(testRandString(t)),
 			},
 			{
 				ImportState:       true,
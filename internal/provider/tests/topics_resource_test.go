@@ -0,0 +1,137 @@
+package tests
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccTopicsResource(t *testing.T) {
+	var cluster = testRandString(t)
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProviderFactories(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTopicsResource(cluster, `
+    orders = {
+      partition_count = 2
+    }
+    payments = {
+      partition_count = 1
+      config = [{
+        name  = "retention.ms"
+        value = "86400000"
+      }]
+    }`),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("warpstream_topics.topics", tfjsonpath.New("topics"), knownvalue.MapSizeExact(2)),
+					statecheck.ExpectKnownValue("warpstream_topics.topics", tfjsonpath.New("topics").AtMapKey("orders").AtMapKey("partition_count"), knownvalue.Int64Exact(2)),
+					statecheck.ExpectKnownValue("warpstream_topics.topics", tfjsonpath.New("topics").AtMapKey("payments").AtMapKey("config"), knownvalue.SetSizeExact(1)),
+				},
+			},
+			// No OP Change
+			{
+				Config: testAccTopicsResource(cluster, `
+    orders = {
+      partition_count = 2
+    }
+    payments = {
+      partition_count = 1
+      config = [{
+        name  = "retention.ms"
+        value = "86400000"
+      }]
+    }`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			{
+				ResourceName:      "warpstream_topics.topics",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(state *terraform.State) (string, error) {
+					topics := state.RootModule().Resources["warpstream_topics.topics"]
+					if topics == nil {
+						return "", fmt.Errorf("topics resource not found in state")
+					}
+					return topics.Primary.Attributes["virtual_cluster_id"] + "/orders,payments", nil
+				},
+				// Configuration isn't imported: the next apply sets it.
+				ImportStateVerifyIgnore: []string{"config", "topics.payments.config"},
+			},
+			// Grow one topic, remove another and add a third, in place.
+			{
+				Config: testAccTopicsResource(cluster, `
+    orders = {
+      partition_count = 4
+    }
+    refunds = {
+      partition_count = 1
+    }`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("warpstream_topics.topics", plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("warpstream_topics.topics", tfjsonpath.New("topics"), knownvalue.MapSizeExact(2)),
+					statecheck.ExpectKnownValue("warpstream_topics.topics", tfjsonpath.New("topics").AtMapKey("orders").AtMapKey("partition_count"), knownvalue.Int64Exact(4)),
+				},
+			},
+			{
+				Config: testAccTopicsResource(cluster, `
+    orders = {
+      partition_count = 1
+    }
+    refunds = {
+      partition_count = 1
+    }`),
+				ExpectError: regexp.MustCompile("cannot be decreased"),
+			},
+			{
+				Config: testAccTopicsResource(cluster, `
+    orders = {
+      partition_count = 4
+      config = [{
+        name  = "cleanup.policy"
+        value = "compact"
+      }]
+    }
+    refunds = {
+      partition_count = 1
+    }`),
+				ExpectError: regexp.MustCompile("cannot be made compacted"),
+			},
+		},
+	})
+}
+
+func testAccTopicsResource(clusterName, topics string) string {
+	return providerConfig + fmt.Sprintf(`
+resource "warpstream_virtual_cluster" "default" {
+  name = "vcn_test_acc_%s"
+  tier = "dev"
+}
+
+resource "warpstream_topics" "topics" {
+  virtual_cluster_id = warpstream_virtual_cluster.default.id
+
+  config {
+    name  = "cleanup.policy"
+    value = "delete"
+  }
+
+  topics = {%s
+  }
+}`, clusterName, topics)
+}
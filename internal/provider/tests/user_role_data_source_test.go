@@ -14,11 +14,11 @@ func TestAccAccountKeyUserRoleDataSource(t *testing.T) {
 	require.NoError(t, err)
 
 	readonlyRoleName := "readonly_test" + nameSuffix
-	readonlyRoleID, err := client.CreateUserRole(readonlyRoleName, []api.AccessGrant{{ManagedGrantKey: "read_only", WorkspaceID: "*", ResourceID: "*"}})
+	readonlyRoleID, err := client.CreateUserRole(t.Context(), readonlyRoleName, []api.AccessGrant{{ManagedGrantKey: "read_only", WorkspaceID: "*", ResourceID: "*"}})
 	require.NoError(t, err)
 
 	defer func() {
-		err = client.DeleteUserRole(readonlyRoleID)
+		err = client.DeleteUserRole(t.Context(), readonlyRoleID)
 		require.NoError(t, err)
 	}()
 
@@ -28,10 +28,10 @@ func TestAccVirtualClusterCredentialsResourceDeletePlan(t *testing.T) {
 					client, err := api.NewClientDefault()
 					require.NoError(t, err)
 
-					virtualCluster, err := client.FindVirtualCluster(fmt.Sprintf("vcn_test_acc_%s", nameSuffix))
+					virtualCluster, err := client.FindVirtualCluster(t.Context(), fmt.Sprintf("vcn_test_acc_%s", nameSuffix))
 					require.NoError(t, err)
 
-					credentials, err := client.GetCredentials(*virtualCluster)
+					credentials, err := client.GetCredentials(t.Context(), *virtualCluster)
 					require.NoError(t, err)
 
 					var vcCredentialID string
@@ -43,7 +43,7 @@ func TestAccVirtualClusterCredentialsResourceDeletePlan(t *testing.T) {
 					}
 					require.NotEmpty(t, vcCredentialID)
 
-					err = client.DeleteCredentials(vcCredentialID, *virtualCluster)
+					err = client.DeleteCredentials(t.Context(), vcCredentialID, *virtualCluster)
 					require.NoError(t, err)
 				},
 				PlanOnly:           true,
@@ -66,10 +66,10 @@ func TestAccVirtualClusterCredentialsResourceDeletePlan(t *testing.T) {
 					client, err := api.NewClientDefault()
 					require.NoError(t, err)
 
-					virtualCluster, err := client.FindVirtualCluster(fmt.Sprintf("vcn_test_acc_%s", nameSuffix))
+					virtualCluster, err := client.FindVirtualCluster(t.Context(), fmt.Sprintf("vcn_test_acc_%s", nameSuffix))
 					require.NoError(t, err)
 
-					err = client.DeleteVirtualCluster(virtualCluster.ID, virtualCluster.Name)
+					err = client.DeleteVirtualCluster(t.Context(), virtualCluster.ID, virtualCluster.Name)
 					require.NoError(t, err)
 				},
 				PlanOnly:           true,
@@ -124,7 +124,7 @@ func TestAccVirtualClusterCredentialsResource(t *testing.T) {
 			},
 			{
 				Config:      testAccVirtualClusterCredentialsResource_vcFieldMissing(),
-				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+				ExpectError: regexp.MustCompile("Missing Virtual Cluster ID"),
 			},
 			// Workaround: re-run the first check so the TF framework cleans up the one with the error above.
 			{
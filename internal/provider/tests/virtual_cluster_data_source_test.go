@@ -19,6 +19,7 @@ func TestAccVirtualClusterDataSource(t *testing.T) {
 	vcNameSuffix := acctest.RandStringFromCharSet(6, acctest.CharSetAlphaNum)
 	region := "us-east-1"
 	vc, err := client.CreateVirtualCluster(
+		t.Context(),
 		testClusterName(vcNameSuffix),
 		api.ClusterParameters{
 			Type:   api.VirtualClusterTypeBYOC,
@@ -30,13 +31,13 @@ func TestAccVirtualClusterDataSource(t *testing.T) {
 	)
 	require.NoError(t, err)
 	defer func() {
-		err := client.DeleteVirtualCluster(vc.ID, vc.Name)
+		err := client.DeleteVirtualCluster(t.Context(), vc.ID, vc.Name)
 		if err != nil {
 			panic(fmt.Errorf("failed to delete virtual cluster: %w", err))
 		}
 	}()
 
-	cfg, err := client.GetConfiguration(*vc)
+	cfg, err := client.GetConfiguration(t.Context(), *vc)
 	require.NoError(t, err)
 
 	agentKeyName := "akn_test_agent_key" + acctest.RandStringFromCharSet(6, acctest.CharSetAlphaNum)
@@ -66,6 +67,7 @@ func TestAccVirtualClusterDataSourceBrokerConfiguration(t *testing.T) {
 	vcNameSuffix := acctest.RandStringFromCharSet(6, acctest.CharSetAlphaNum)
 	region := "us-east-1"
 	vc, err := client.CreateVirtualCluster(
+		t.Context(),
 		testClusterName(vcNameSuffix),
 		api.ClusterParameters{
 			Type:   api.VirtualClusterTypeBYOC,
@@ -76,7 +78,7 @@ func TestAccVirtualClusterDataSourceBrokerConfiguration(t *testing.T) {
 	)
 	require.NoError(t, err)
 	defer func() {
-		if err := client.DeleteVirtualCluster(vc.ID, vc.Name); err != nil {
+		if err := client.DeleteVirtualCluster(t.Context(), vc.ID, vc.Name); err != nil {
 			panic(fmt.Errorf("failed to delete virtual cluster: %w", err))
 		}
 	}()
@@ -84,7 +86,7 @@ func TestAccVirtualClusterDataSourceBrokerConfiguration(t *testing.T) {
 	// Set two configs straight through the API, so Terraform has no configuration declaring them
 	// and can only be reporting what the cluster actually holds.
 	maxBytes, retention := "1048576", "604800000"
-	require.NoError(t, client.UpdateConfiguration(api.ConfigurationUpdate{
+	require.NoError(t, client.UpdateConfiguration(t.Context(), api.ConfigurationUpdate{
 		BrokerConfigs: map[string]string{
 			"message.max.bytes": maxBytes,
 			"log.retention.ms":  retention,
@@ -113,7 +115,7 @@ func TestAccVirtualClusterDataSourceTopicTypeNeverEmpty(t *testing.T) {
 
 	vcNameSuffix := acctest.RandStringFromCharSet(6, acctest.CharSetAlphaNum)
 	region := "us-east-1"
-	vc, err := client.CreateVirtualCluster(testClusterName(vcNameSuffix), api.ClusterParameters{
+	vc, err := client.CreateVirtualCluster(t.Context(), testClusterName(vcNameSuffix), api.ClusterParameters{
 		Type:   api.VirtualClusterTypeBYOC,
 		Tier:   api.VirtualClusterTierPro,
 		Region: &region,
@@ -121,7 +123,7 @@ func TestAccVirtualClusterDataSourceTopicTypeNeverEmpty(t *testing.T) {
 	})
 	require.NoError(t, err)
 	defer func() {
-		if err := client.DeleteVirtualCluster(vc.ID, vc.Name); err != nil {
+		if err := client.DeleteVirtualCluster(t.Context(), vc.ID, vc.Name); err != nil {
 			panic(fmt.Errorf("failed to delete virtual cluster: %w", err))
 		}
 	}()
@@ -246,6 +248,7 @@ func TestAccVirtualClusterDataSourceWithEvents(t *testing.T) {
 	vcNameSuffix := acctest.RandStringFromCharSet(6, acctest.CharSetAlphaNum)
 	region := "us-east-1"
 	vc, err := client.CreateVirtualCluster(
+		t.Context(),
 		testClusterName(vcNameSuffix),
 		api.ClusterParameters{
 			Type:   api.VirtualClusterTypeBYOC,
@@ -257,7 +260,7 @@ func TestAccVirtualClusterDataSourceWithEvents(t *testing.T) {
 	)
 	require.NoError(t, err)
 	defer func() {
-		err := client.DeleteVirtualCluster(vc.ID, vc.Name)
+		err := client.DeleteVirtualCluster(t.Context(), vc.ID, vc.Name)
 		if err != nil {
 			panic(fmt.Errorf("failed to delete virtual cluster: %w", err))
 		}
@@ -265,11 +268,11 @@ func TestAccVirtualClusterDataSourceWithEvents(t *testing.T) {
 
 	// Enable events for the cluster
 	enabled := true
-	err = client.UpdateEventsState(&enabled, nil, *vc)
+	err = client.UpdateEventsState(t.Context(), &enabled, nil, *vc)
 	require.NoError(t, err)
 
 	// Verify events are enabled
-	eventsState, err := client.GetEventsState(*vc)
+	eventsState, err := client.GetEventsState(t.Context(), *vc)
 	require.NoError(t, err)
 	require.True(t, eventsState.Enabled, "expected events to be enabled")
 
@@ -301,6 +304,7 @@ func TestAccVirtualClusterDataSourceWithEventsDisabled(t *testing.T) {
 	vcNameSuffix := acctest.RandStringFromCharSet(6, acctest.CharSetAlphaNum)
 	region := "us-east-1"
 	vc, err := client.CreateVirtualCluster(
+		t.Context(),
 		testClusterName(vcNameSuffix),
 		api.ClusterParameters{
 			Type:   api.VirtualClusterTypeBYOC,
@@ -312,14 +316,14 @@ func TestAccVirtualClusterDataSourceWithEventsDisabled(t *testing.T) {
 	)
 	require.NoError(t, err)
 	defer func() {
-		err := client.DeleteVirtualCluster(vc.ID, vc.Name)
+		err := client.DeleteVirtualCluster(t.Context(), vc.ID, vc.Name)
 		if err != nil {
 			panic(fmt.Errorf("failed to delete virtual cluster: %w", err))
 		}
 	}()
 
 	// Verify events are disabled by default
-	eventsState, err := client.GetEventsState(*vc)
+	eventsState, err := client.GetEventsState(t.Context(), *vc)
 	require.NoError(t, err)
 	require.False(t, eventsState.Enabled, "expected events to be disabled by default")
 
@@ -443,6 +447,7 @@ func TestAccVirtualClusterDatasource_SchemaRegistryNotWork(t *testing.T) {
 	vcNameSuffix := acctest.RandStringFromCharSet(6, acctest.CharSetAlphaNum)
 	region := "us-east-1"
 	vc, err := client.CreateVirtualCluster(
+		t.Context(),
 		testClusterName(vcNameSuffix),
 		api.ClusterParameters{
 			Type:   api.VirtualClusterTypeSchemaRegistry,
@@ -453,7 +458,7 @@ func TestAccVirtualClusterDatasource_SchemaRegistryNotWork(t *testing.T) {
 	)
 	require.NoError(t, err)
 	defer func() {
-		err := client.DeleteVirtualCluster(vc.ID, vc.Name)
+		err := client.DeleteVirtualCluster(t.Context(), vc.ID, vc.Name)
 		if err != nil {
 			panic(fmt.Errorf("failed to delete virtual cluster: %w", err))
 		}
@@ -5,7 +5,6 @@ import (
 	"regexp"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -17,9 +16,9 @@ import (
 )
 
 func TestAccVirtualClusterResourceDeletePlan(t *testing.T) {
-	vcNameSuffix := acctest.RandStringFromCharSet(6, acctest.CharSetAlphaNum)
+	vcNameSuffix := testRandString(t)
 	resource.Test(t, resource.TestCase{
-		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: testAccProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccVirtualClusterResource_withPartialConfiguration(false, vcNameSuffix),
@@ -27,13 +26,13 @@ func TestAccVirtualClusterResourceDeletePlan(t *testing.T) {
 			},
 			{
 				PreConfig: func() {
-					client, err := api.NewClientDefault()
+					client, err := newTestAPIClient(t)
 					require.NoError(t, err)
 
-					virtualCluster, err := client.FindVirtualCluster(fmt.Sprintf("vcn_test_acc_%s", vcNameSuffix))
+					virtualCluster, err := client.FindVirtualCluster(t.Context(), fmt.Sprintf("vcn_test_acc_%s", vcNameSuffix))
 					require.NoError(t, err)
 
-					err = client.DeleteVirtualCluster(virtualCluster.ID, virtualCluster.Name)
+					err = client.DeleteVirtualCluster(t.Context(), virtualCluster.ID, virtualCluster.Name)
 					require.NoError(t, err)
 				},
 				PlanOnly:           true,
@@ -50,10 +49,10 @@ func TestAccVirtualClusterResourceDeletePlan(t *testing.T) {
 }
 
 func TestAccVirtualClusterResource(t *testing.T) {
-	vcNameSuffix := acctest.RandStringFromCharSet(6, acctest.CharSetAlphaNum)
+	vcNameSuffix := testRandString(t)
 	var clusterID string
 	resource.Test(t, resource.TestCase{
-		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: testAccProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccVirtualClusterResource_withPartialConfiguration(false, vcNameSuffix),
@@ -277,7 +276,7 @@ resource "warpstream_virtual_cluster" "test" {
 // TestAccVirtualClusterResourceBrokerConfigInvalid covers every input the provider refuses
 // before calling the API.
 func TestAccVirtualClusterResourceBrokerConfigInvalid(t *testing.T) {
-	vcNameSuffix := acctest.RandStringFromCharSet(6, acctest.CharSetAlphaNum)
+	vcNameSuffix := testRandString(t)
 
 	cases := []struct {
 		name       string
@@ -333,7 +332,7 @@ func TestAccVirtualClusterResourceBrokerConfigInvalid(t *testing.T) {
 	}
 
 	resource.Test(t, resource.TestCase{
-		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: testAccProviderFactories(t),
 		Steps:                    steps,
 	})
 }
@@ -347,10 +346,10 @@ func TestAccVirtualClusterResourceBrokerConfigInvalid(t *testing.T) {
 // that follows the write, which reports the exact value to use. Neither needs the provider to
 // know anything about the config in question.
 func TestAccVirtualClusterResourceBrokerConfigRejectedByAPI(t *testing.T) {
-	vcNameSuffix := acctest.RandStringFromCharSet(6, acctest.CharSetAlphaNum)
+	vcNameSuffix := testRandString(t)
 
 	resource.Test(t, resource.TestCase{
-		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: testAccProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config:      brokerConfigResource(vcNameSuffix, "", `    "messge.max.bytes" = "1048576"`),
@@ -379,7 +378,7 @@ func TestAccVirtualClusterResourceBrokerConfigRejectedByAPI(t *testing.T) {
 // TestAccVirtualClusterResourceBrokerConfigLifecycle asserts the create/update/remove cycle for
 // configs that have no typed `configuration` equivalent.
 func TestAccVirtualClusterResourceBrokerConfigLifecycle(t *testing.T) {
-	vcNameSuffix := acctest.RandStringFromCharSet(6, acctest.CharSetAlphaNum)
+	vcNameSuffix := testRandString(t)
 	const addr = "warpstream_virtual_cluster.test"
 
 	twoConfigs := `    "message.max.bytes"   = "1048576"
@@ -389,7 +388,7 @@ func TestAccVirtualClusterResourceBrokerConfigLifecycle(t *testing.T) {
     "offsets.retention.minutes" = "10080"`
 
 	resource.Test(t, resource.TestCase{
-		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: testAccProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: brokerConfigResource(vcNameSuffix, "", twoConfigs),
@@ -443,7 +442,7 @@ func TestAccVirtualClusterResourceBrokerConfigLifecycle(t *testing.T) {
 // configuration written against the released provider, which has no `broker_configuration`
 // attribute at all, must plan clean.
 func TestAccVirtualClusterResourceBrokerConfigUpgrade(t *testing.T) {
-	vcNameSuffix := acctest.RandStringFromCharSet(6, acctest.CharSetAlphaNum)
+	vcNameSuffix := testRandString(t)
 	const addr = "warpstream_virtual_cluster.test"
 
 	// A configuration the released provider understands: typed attributes, no map.
@@ -462,14 +461,14 @@ func TestAccVirtualClusterResourceBrokerConfigUpgrade(t *testing.T) {
 				Config:            config,
 			},
 			{
-				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				ProtoV6ProviderFactories: testAccProviderFactories(t),
 				Config:                   config,
 				ConfigPlanChecks:         emptyPlanChecks,
 			},
 			// Taking over is not enough: the new provider must also be able to write these
 			// settings through their new representation without changing what they mean.
 			{
-				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				ProtoV6ProviderFactories: testAccProviderFactories(t),
 				Config: brokerConfigResource(vcNameSuffix, `    auto_create_topic              = false
     default_num_partitions         = 4
     default_retention_millis       = 7200000
@@ -491,7 +490,7 @@ func TestAccVirtualClusterResourceBrokerConfigUpgrade(t *testing.T) {
 // settings the map rejects: all of them must round-trip  and a re-apply must plan
 // nothing. Deleting a typed attribute reverts the cluster to the schema default.
 func TestAccVirtualClusterResourceBrokerConfigTypedSettings(t *testing.T) {
-	vcNameSuffix := acctest.RandStringFromCharSet(6, acctest.CharSetAlphaNum)
+	vcNameSuffix := testRandString(t)
 	const addr = "warpstream_virtual_cluster.test"
 
 	allSixTyped := `    auto_create_topic              = false
@@ -508,7 +507,7 @@ func TestAccVirtualClusterResourceBrokerConfigTypedSettings(t *testing.T) {
     default_topic_type             = "lightning"`
 
 	resource.Test(t, resource.TestCase{
-		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: testAccProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: brokerConfigResource(vcNameSuffix, allSixTyped, ""),
@@ -544,7 +543,7 @@ func TestAccVirtualClusterResourceBrokerConfigTypedSettings(t *testing.T) {
 // by side on one cluster: typed attributes own their settings, the map owns the rest, and each
 // surface changes independently with settled plans in between.
 func TestAccVirtualClusterResourceBrokerConfigCoexist(t *testing.T) {
-	vcNameSuffix := acctest.RandStringFromCharSet(6, acctest.CharSetAlphaNum)
+	vcNameSuffix := testRandString(t)
 	const addr = "warpstream_virtual_cluster.test"
 
 	both := func(retention, maxBytes string) string {
@@ -554,7 +553,7 @@ func TestAccVirtualClusterResourceBrokerConfigCoexist(t *testing.T) {
 	}
 
 	resource.Test(t, resource.TestCase{
-		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: testAccProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: both("3600000", "1048576"),
@@ -589,7 +588,7 @@ func TestAccVirtualClusterResourceBrokerConfigCoexist(t *testing.T) {
 // until apply, which is what happens whenever a config is derived from another resource.
 // Extracting the map must not fail at plan time and the plan must settle afterwards.
 func TestAccVirtualClusterResourceBrokerConfigUnknownValue(t *testing.T) {
-	vcNameSuffix := acctest.RandStringFromCharSet(6, acctest.CharSetAlphaNum)
+	vcNameSuffix := testRandString(t)
 	const addr = "warpstream_virtual_cluster.test"
 
 	// The dependency cluster's id is unknown until it is created, so the value derived from it
@@ -609,7 +608,7 @@ resource "warpstream_virtual_cluster" "test" {
 }`, vcNameSuffix, vcNameSuffix)
 
 	resource.Test(t, resource.TestCase{
-		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: testAccProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: config,
@@ -628,7 +627,7 @@ resource "warpstream_virtual_cluster" "test" {
 // key validation cannot run against an opaque map at plan time, so a typed-owned key hiding in
 // one must still be rejected during the apply-time re-plan, before anything is written.
 func TestAccVirtualClusterResourceBrokerConfigWholeMapUnknown(t *testing.T) {
-	vcNameSuffix := acctest.RandStringFromCharSet(6, acctest.CharSetAlphaNum)
+	vcNameSuffix := testRandString(t)
 	const addr = "warpstream_virtual_cluster.test"
 
 	// The JSON string interpolates the dependency cluster's id, so the whole decoded map is
@@ -662,7 +661,7 @@ resource "warpstream_virtual_cluster" "test" {
 	}
 
 	resource.Test(t, resource.TestCase{
-		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: testAccProviderFactories(t),
 		Steps: []resource.TestStep{
 			// Create with a wholly-unknown map.
 			{
@@ -701,14 +700,14 @@ resource "warpstream_virtual_cluster" "test" {
 // Retention is set through its typed attribute, but the wire representation is the generic
 // broker_configs field, so this guards that log.retention.ms stays 64-bit end to end.
 func TestAccVirtualClusterResourceBrokerConfigLargeRetention(t *testing.T) {
-	vcNameSuffix := acctest.RandStringFromCharSet(6, acctest.CharSetAlphaNum)
+	vcNameSuffix := testRandString(t)
 	const addr = "warpstream_virtual_cluster.test"
 	const thirtyDaysMillis = "2592000000"
 
 	config := brokerConfigResource(vcNameSuffix, "    default_retention_millis = "+thirtyDaysMillis, "")
 
 	resource.Test(t, resource.TestCase{
-		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: testAccProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: config,
@@ -723,10 +722,10 @@ func TestAccVirtualClusterResourceBrokerConfigLargeRetention(t *testing.T) {
 }
 
 func TestAccVirtualClusterResourceBrokerConfigDrift(t *testing.T) {
-	client, err := api.NewClientDefault()
+	client, err := newTestAPIClient(t)
 	require.NoError(t, err)
 
-	vcNameSuffix := acctest.RandStringFromCharSet(6, acctest.CharSetAlphaNum)
+	vcNameSuffix := testRandString(t)
 	const addr = "warpstream_virtual_cluster.test"
 	config := brokerConfigResource(vcNameSuffix, "", `    "message.max.bytes" = "1048576"`)
 
@@ -736,6 +735,7 @@ func TestAccVirtualClusterResourceBrokerConfigDrift(t *testing.T) {
 	writeOutOfBand := func(kv map[string]string) func() {
 		return func() {
 			require.NoError(t, client.UpdateConfiguration(
+				t.Context(),
 				api.ConfigurationUpdate{BrokerConfigs: kv},
 				api.VirtualCluster{ID: clusterID},
 			))
@@ -743,7 +743,7 @@ func TestAccVirtualClusterResourceBrokerConfigDrift(t *testing.T) {
 	}
 
 	resource.Test(t, resource.TestCase{
-		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: testAccProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: config,
@@ -786,7 +786,7 @@ func TestAccVirtualClusterResourceBrokerConfigDrift(t *testing.T) {
 }
 
 func TestAccVirtualClusterResourceBrokerConfigUpgradeDefaults(t *testing.T) {
-	vcNameSuffix := acctest.RandStringFromCharSet(6, acctest.CharSetAlphaNum)
+	vcNameSuffix := testRandString(t)
 	const addr = "warpstream_virtual_cluster.test"
 	config := brokerConfigResource(vcNameSuffix, "", "")
 
@@ -797,14 +797,14 @@ func TestAccVirtualClusterResourceBrokerConfigUpgradeDefaults(t *testing.T) {
 				Config:            config,
 			},
 			{
-				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				ProtoV6ProviderFactories: testAccProviderFactories(t),
 				Config:                   config,
 				ConfigPlanChecks:         emptyPlanChecks,
 			},
 			// Planning clean is not enough: the defaults must still be the defaults once this
 			// provider has written them through their new representation.
 			{
-				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				ProtoV6ProviderFactories: testAccProviderFactories(t),
 				Config:                   config,
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr(addr, "configuration.auto_create_topic", "true"),
@@ -825,12 +825,12 @@ func TestAccVirtualClusterResourceBrokerConfigUpgradeDefaults(t *testing.T) {
 // rejected by the API — during the create that follows a fresh cluster, and during a later update
 // — and requires the resource to be recoverable from each.
 func TestAccVirtualClusterResourceBrokerConfigFailureRecovery(t *testing.T) {
-	vcNameSuffix := acctest.RandStringFromCharSet(6, acctest.CharSetAlphaNum)
+	vcNameSuffix := testRandString(t)
 	const addr = "warpstream_virtual_cluster.test"
 	good := brokerConfigResource(vcNameSuffix, "", `    "message.max.bytes" = "1048576"`)
 
 	resource.Test(t, resource.TestCase{
-		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: testAccProviderFactories(t),
 		Steps: []resource.TestStep{
 			// The cluster is created and then fails to configure.
 			{
@@ -860,7 +860,7 @@ func TestAccVirtualClusterResourceBrokerConfigFailureRecovery(t *testing.T) {
 // Events are the attribute a botched failure path corrupts first, because it is the one carrying
 // unknowns through the apply, so the plain case with both populated is worth holding still.
 func TestAccVirtualClusterResourceBrokerConfigWithEvents(t *testing.T) {
-	vcNameSuffix := acctest.RandStringFromCharSet(6, acctest.CharSetAlphaNum)
+	vcNameSuffix := testRandString(t)
 	const addr = "warpstream_virtual_cluster.test"
 	withEvents := func(brokerValue string) string {
 		return providerConfig + fmt.Sprintf(`
@@ -880,7 +880,7 @@ resource "warpstream_virtual_cluster" "test" {
 	good := withEvents("1048576")
 
 	resource.Test(t, resource.TestCase{
-		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: testAccProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config:      withEvents("not-a-number"),
@@ -911,13 +911,13 @@ resource "warpstream_virtual_cluster" "test" {
 // TestAccVirtualClusterResourceBrokerConfigImport covers importing a cluster whose configs are
 // map-managed.
 func TestAccVirtualClusterResourceBrokerConfigImport(t *testing.T) {
-	vcNameSuffix := acctest.RandStringFromCharSet(6, acctest.CharSetAlphaNum)
+	vcNameSuffix := testRandString(t)
 	const addr = "warpstream_virtual_cluster.test"
 	config := brokerConfigResource(vcNameSuffix, `    default_retention_millis = 3600000`,
 		`    "message.max.bytes" = "1048576"`)
 
 	resource.Test(t, resource.TestCase{
-		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: testAccProviderFactories(t),
 		Steps: []resource.TestStep{
 			{Config: config},
 			{
@@ -956,12 +956,12 @@ func TestAccVirtualClusterResourceBrokerConfigImport(t *testing.T) {
 // If the API ever gains a name for one of these, this test fails — which is the signal to add it
 // to typedAttrConfigs before the map can be used to write it behind the typed attribute's back.
 func TestAccVirtualClusterConfigSurfacesAreDisjoint(t *testing.T) {
-	client, err := api.NewClientDefault()
+	client, err := newTestAPIClient(t)
 	require.NoError(t, err)
 
-	vcNameSuffix := acctest.RandStringFromCharSet(6, acctest.CharSetAlphaNum)
+	vcNameSuffix := testRandString(t)
 	region := "us-east-1"
-	vc, err := client.CreateVirtualCluster(testClusterName(vcNameSuffix), api.ClusterParameters{
+	vc, err := client.CreateVirtualCluster(t.Context(), testClusterName(vcNameSuffix), api.ClusterParameters{
 		Type:   api.VirtualClusterTypeBYOC,
 		Tier:   api.VirtualClusterTierPro,
 		Region: &region,
@@ -969,7 +969,7 @@ func TestAccVirtualClusterConfigSurfacesAreDisjoint(t *testing.T) {
 	})
 	require.NoError(t, err)
 	defer func() {
-		if err := client.DeleteVirtualCluster(vc.ID, vc.Name); err != nil {
+		if err := client.DeleteVirtualCluster(t.Context(), vc.ID, vc.Name); err != nil {
 			panic(fmt.Errorf("failed to delete virtual cluster: %w", err))
 		}
 	}()
@@ -979,7 +979,7 @@ func TestAccVirtualClusterConfigSurfacesAreDisjoint(t *testing.T) {
 		"warpstream.acl.shadowing.enable",
 		"warpstream.deletion.protection.enable",
 	} {
-		err := client.UpdateConfiguration(api.ConfigurationUpdate{
+		err := client.UpdateConfiguration(t.Context(), api.ConfigurationUpdate{
 			BrokerConfigs: map[string]string{key: "true"},
 		}, *vc)
 		require.ErrorContains(t, err, "unsupported cluster config",
@@ -988,16 +988,16 @@ func TestAccVirtualClusterConfigSurfacesAreDisjoint(t *testing.T) {
 	}
 
 	// Sanity check that the loop above proves something: a name the API does accept must work.
-	require.NoError(t, client.UpdateConfiguration(api.ConfigurationUpdate{
+	require.NoError(t, client.UpdateConfiguration(t.Context(), api.ConfigurationUpdate{
 		BrokerConfigs: map[string]string{"message.max.bytes": "1048576"},
 	}, *vc))
 
 	for alias, canonical := range resources.WriteOnlyAliasKeys {
-		require.NoError(t, client.UpdateConfiguration(api.ConfigurationUpdate{
+		require.NoError(t, client.UpdateConfiguration(t.Context(), api.ConfigurationUpdate{
 			BrokerConfigs: map[string]string{alias: "1"},
 		}, *vc), "the API no longer accepts %q on write, so it need not be rejected at all", alias)
 
-		cfg, err := client.GetConfiguration(*vc)
+		cfg, err := client.GetConfiguration(t.Context(), *vc)
 		require.NoError(t, err)
 
 		require.NotContains(t, cfg.BrokerConfigs, alias,
@@ -1010,9 +1010,9 @@ func TestAccVirtualClusterConfigSurfacesAreDisjoint(t *testing.T) {
 }
 
 func TestAccVirtualClusterImport(t *testing.T) {
-	vcNameSuffix := acctest.RandStringFromCharSet(6, acctest.CharSetAlphaNum)
+	vcNameSuffix := testRandString(t)
 	resource.Test(t, resource.TestCase{
-		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: testAccProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccVirtualClusterResource_withDefaultTopicType(vcNameSuffix, "classic"),
@@ -1028,9 +1028,9 @@ func TestAccVirtualClusterImport(t *testing.T) {
 }
 
 func TestAccVirtualClusterResourceWithSoftDeletion(t *testing.T) {
-	vcNameSuffix := acctest.RandStringFromCharSet(6, acctest.CharSetAlphaNum)
+	vcNameSuffix := testRandString(t)
 	resource.Test(t, resource.TestCase{
-		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: testAccProviderFactories(t),
 		Steps: []resource.TestStep{
 			{
 				Config: testAccVirtualClusterResource_withSoftDeletionSettings(vcNameSuffix, false, 48),
@@ -1065,9 +1065,9 @@ resource "warpstream_virtual_cluster" "test" {
 }
 
 func TestAccVirtualClusterResourceWithDefaultTopicType(t *testing.T) {
-	vcNameSuffix := acctest.RandStringFromCharSet(6, acctest.CharSetAlphaNum)
+	vcNameSuffix := testRandString(t)
 	resource.Test(t, resource.TestCase{
-		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: testAccProviderFactories(t),
 		Steps: []resource.TestStep{
 			// Create without default_topic_type (should be null)
 			{
@@ -1111,9 +1111,9 @@ resource "warpstream_virtual_cluster" "test" {
 }
 
 func TestAccVirtualClusterResourceWithEvents(t *testing.T) {
-	vcNameSuffix := acctest.RandStringFromCharSet(6, acctest.CharSetAlphaNum)
+	vcNameSuffix := testRandString(t)
 	resource.Test(t, resource.TestCase{
-		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: testAccProviderFactories(t),
 		Steps: []resource.TestStep{
 			// Create with events disabled (explicit)
 			{
@@ -1141,9 +1141,9 @@ func TestAccVirtualClusterResourceWithEvents(t *testing.T) {
 }
 
 func TestAccVirtualClusterResourceWithEventsDefault(t *testing.T) {
-	vcNameSuffix := acctest.RandStringFromCharSet(6, acctest.CharSetAlphaNum)
+	vcNameSuffix := testRandString(t)
 	resource.Test(t, resource.TestCase{
-		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: testAccProviderFactories(t),
 		Steps: []resource.TestStep{
 			// Create without events block - should default to disabled
 			{
@@ -1168,9 +1168,9 @@ resource "warpstream_virtual_cluster" "test" {
 }
 
 func TestAccVirtualClusterResourceWithEventTypes(t *testing.T) {
-	vcNameSuffix := acctest.RandStringFromCharSet(6, acctest.CharSetAlphaNum)
+	vcNameSuffix := testRandString(t)
 	resource.Test(t, resource.TestCase{
-		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: testAccProviderFactories(t),
 		Steps: []resource.TestStep{
 			// Create with event types configured
 			{
@@ -1210,9 +1210,9 @@ func TestAccVirtualClusterResourceWithEventTypes(t *testing.T) {
 }
 
 func TestAccVirtualClusterResourceEventTypesAllTypes(t *testing.T) {
-	vcNameSuffix := acctest.RandStringFromCharSet(6, acctest.CharSetAlphaNum)
+	vcNameSuffix := testRandString(t)
 	resource.Test(t, resource.TestCase{
-		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: testAccProviderFactories(t),
 		Steps: []resource.TestStep{
 			// Create with all three event types configured
 			{
@@ -28,6 +28,7 @@ func TestAccVirtualClustersDataSource(t *testing.T) {
 	for range 5 {
 		vcNameSuffix := acctest.RandStringFromCharSet(6, acctest.CharSetAlphaNum)
 		vc, err := client.CreateVirtualCluster(
+			t.Context(),
 			testClusterName(vcNameSuffix),
 			api.ClusterParameters{
 				Type:   api.VirtualClusterTypeBYOC,
@@ -46,7 +47,7 @@ func TestAccVirtualClustersDataSource(t *testing.T) {
 	defer func() {
 		for _, vc := range createdVCs {
 
-			err := client.DeleteVirtualCluster(vc.ID, vc.Name)
+			err := client.DeleteVirtualCluster(t.Context(), vc.ID, vc.Name)
 			if err != nil {
 				panic(fmt.Errorf("failed to delete virtual cluster: %w", err))
 			}
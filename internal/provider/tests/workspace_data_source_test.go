@@ -13,19 +13,19 @@ func TestAccAccountKeyWorkspaceDataSource(t *testing.T) {
 	workspaceName := "test_workspace_" + nameSuffix
 	client, err := api.NewClientDefault()
 	require.NoError(t, err)
-	workspaceID, err := client.CreateWorkspace(workspaceName)
+	workspaceID, err := client.CreateWorkspace(t.Context(), workspaceName)
 	require.NoError(t, err)
 
 	appKey1Name := "akn_test_workspace_application_key_1_" + nameSuffix
 	appKey2Name := "akn_test_workspace_application_key_2_" + nameSuffix
-	_, err = client.CreateApplicationKey(appKey1Name, workspaceID, false)
+	_, err = client.CreateApplicationKey(t.Context(), appKey1Name, workspaceID, false)
 	require.NoError(t, err)
-	_, err = client.CreateApplicationKey(appKey2Name, workspaceID, false)
+	_, err = client.CreateApplicationKey(t.Context(), appKey2Name, workspaceID, false)
 	require.NoError(t, err)
 
 	defer func() {
 		// Workspace deletion also revokes associated application keys.
-		err = client.DeleteWorkspace(workspaceID)
+		err = client.DeleteWorkspace(t.Context(), workspaceID)
 		require.NoError(t, err)
 	}()
 
@@ -0,0 +1,119 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// tokenCommandTimeout caps how long token_command may run.
+const tokenCommandTimeout = time.Minute
+
+// resolveToken returns the API token from the first source that is set: the token, token_file or
+// token_command attribute, then the selected profile, then the WARPSTREAM_API_KEY,
+// WARPSTREAM_API_KEY_FILE or WARPSTREAM_API_KEY_COMMAND environment variable. It returns "" when
+// no source is set, or when the configured one is not known yet.
+//
+// Files are read on every Configure, so a rotated token is picked up by the next run; command
+// output is cached for the life of the provider.
+func (p *warpstreamProvider) resolveToken(ctx context.Context, config warpstreamProviderModel, profile credentialsProfile, diags *diag.Diagnostics) string {
+	for _, attr := range []types.String{config.Token, config.TokenFile, config.TokenCommand} {
+		if attr.IsUnknown() {
+			return ""
+		}
+	}
+
+	switch {
+	case !config.Token.IsNull():
+		return config.Token.ValueString()
+	case !config.TokenFile.IsNull():
+		return readTokenFile(config.TokenFile.ValueString(), "token_file", diags)
+	case !config.TokenCommand.IsNull():
+		return p.runTokenCommand(ctx, config.TokenCommand.ValueString(), "token_command", diags)
+	case profile.Token != "":
+		return profile.Token
+	}
+
+	if token := os.Getenv("WARPSTREAM_API_KEY"); token != "" {
+		return token
+	}
+	if file := os.Getenv("WARPSTREAM_API_KEY_FILE"); file != "" {
+		return readTokenFile(file, "WARPSTREAM_API_KEY_FILE", diags)
+	}
+	if command := os.Getenv("WARPSTREAM_API_KEY_COMMAND"); command != "" {
+		return p.runTokenCommand(ctx, command, "WARPSTREAM_API_KEY_COMMAND", diags)
+	}
+	return ""
+}
+
+func readTokenFile(file, source string, diags *diag.Diagnostics) string {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		diags.AddAttributeError(path.Root("token_file"), "Unable to Read API Token",
+			fmt.Sprintf("Reading the token file %s named by %s: %s.", file, source, err))
+		return ""
+	}
+
+	token := strings.TrimSpace(string(data))
+	if token == "" {
+		diags.AddAttributeError(path.Root("token_file"), "Empty API Token",
+			fmt.Sprintf("The token file %s named by %s is empty.", file, source))
+	}
+	return token
+}
+
+// runTokenCommand runs command through the shell and returns its trimmed standard output. Output
+// is cached per command for the life of the provider, so the command runs once however many times
+// the provider is configured.
+func (p *warpstreamProvider) runTokenCommand(ctx context.Context, command, source string, diags *diag.Diagnostics) string {
+	p.tokenCommandMu.Lock()
+	defer p.tokenCommandMu.Unlock()
+	if token, ok := p.tokenCommandCache[command]; ok {
+		return token
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, tokenCommandTimeout)
+	defer cancel()
+
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.CommandContext(ctx, "cmd", "/C", command)
+	} else {
+		cmd = exec.CommandContext(ctx, "sh", "-c", command)
+	}
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+
+	out, err := cmd.Output()
+	if err != nil {
+		detail := fmt.Sprintf("Running the token command named by %s failed: %s.", source, err)
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			detail += "\n\nCommand output:\n" + msg
+		}
+		diags.AddAttributeError(path.Root("token_command"), "Unable to Run API Token Command", detail)
+		return ""
+	}
+
+	token := strings.TrimSpace(string(out))
+	if token == "" {
+		diags.AddAttributeError(path.Root("token_command"), "Empty API Token",
+			fmt.Sprintf("The token command named by %s succeeded but printed nothing on standard output. "+
+				"It must print the WarpStream API key.", source))
+		return ""
+	}
+
+	if p.tokenCommandCache == nil {
+		p.tokenCommandCache = map[string]string{}
+	}
+	p.tokenCommandCache[command] = token
+	return token
+}
@@ -0,0 +1,170 @@
+package provider
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveTokenPrecedence(t *testing.T) {
+	dir := t.TempDir()
+	configFile := filepath.Join(dir, "config-token")
+	envFile := filepath.Join(dir, "env-token")
+	require.NoError(t, os.WriteFile(configFile, []byte("aks_from_config_file\n"), 0o600))
+	require.NoError(t, os.WriteFile(envFile, []byte("aks_from_env_file\n"), 0o600))
+
+	tests := []struct {
+		name    string
+		env     map[string]string
+		config  warpstreamProviderModel
+		profile credentialsProfile
+		want    string
+	}{
+		{
+			name:   "token attribute beats environment",
+			env:    map[string]string{"WARPSTREAM_API_KEY": "aks_from_env"},
+			config: warpstreamProviderModel{Token: types.StringValue("aks_from_config")},
+			want:   "aks_from_config",
+		},
+		{
+			name:   "token_file attribute beats environment",
+			env:    map[string]string{"WARPSTREAM_API_KEY": "aks_from_env"},
+			config: warpstreamProviderModel{TokenFile: types.StringValue(configFile)},
+			want:   "aks_from_config_file",
+		},
+		{
+			name:    "token attribute beats profile",
+			config:  warpstreamProviderModel{Token: types.StringValue("aks_from_config")},
+			profile: credentialsProfile{Token: "aks_from_profile"},
+			want:    "aks_from_config",
+		},
+		{
+			name:    "profile beats environment",
+			env:     map[string]string{"WARPSTREAM_API_KEY": "aks_from_env"},
+			profile: credentialsProfile{Token: "aks_from_profile"},
+			want:    "aks_from_profile",
+		},
+		{
+			name:    "environment fills in a profile without a token",
+			env:     map[string]string{"WARPSTREAM_API_KEY": "aks_from_env"},
+			profile: credentialsProfile{BaseURL: "https://api.example.com/api/v1"},
+			want:    "aks_from_env",
+		},
+		{
+			name: "environment token beats environment file",
+			env:  map[string]string{"WARPSTREAM_API_KEY": "aks_from_env", "WARPSTREAM_API_KEY_FILE": envFile},
+			want: "aks_from_env",
+		},
+		{
+			name: "environment file beats environment command",
+			env:  map[string]string{"WARPSTREAM_API_KEY_FILE": envFile, "WARPSTREAM_API_KEY_COMMAND": "echo aks_from_env_command"},
+			want: "aks_from_env_file",
+		},
+		{
+			name: "nothing set",
+			want: "",
+		},
+		{
+			name:   "unknown token",
+			env:    map[string]string{"WARPSTREAM_API_KEY": "aks_from_env"},
+			config: warpstreamProviderModel{Token: types.StringUnknown()},
+			want:   "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("WARPSTREAM_API_KEY", "")
+			t.Setenv("WARPSTREAM_API_KEY_FILE", "")
+			t.Setenv("WARPSTREAM_API_KEY_COMMAND", "")
+			for k, v := range tt.env {
+				t.Setenv(k, v)
+			}
+
+			var diags diag.Diagnostics
+			token := (&warpstreamProvider{}).resolveToken(t.Context(), tt.config, tt.profile, &diags)
+
+			require.False(t, diags.HasError(), diags)
+			require.Equal(t, tt.want, token)
+		})
+	}
+}
+
+func TestResolveTokenEmptyFile(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(file, []byte("\n"), 0o600))
+
+	var diags diag.Diagnostics
+	(&warpstreamProvider{}).resolveToken(t.Context(), warpstreamProviderModel{TokenFile: types.StringValue(file)}, credentialsProfile{}, &diags)
+
+	require.True(t, diags.HasError())
+	require.Contains(t, diags.Errors()[0].Detail(), "is empty")
+}
+
+func TestResolveTokenCommand(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("the test commands need a POSIX shell")
+	}
+
+	runs := filepath.Join(t.TempDir(), "runs")
+	command := "echo run >> " + runs + " && echo ' aks_from_command '"
+	p := &warpstreamProvider{}
+
+	for range 2 {
+		var diags diag.Diagnostics
+		token := p.resolveToken(t.Context(), warpstreamProviderModel{TokenCommand: types.StringValue(command)}, credentialsProfile{}, &diags)
+
+		require.False(t, diags.HasError(), diags)
+		require.Equal(t, "aks_from_command", token)
+	}
+
+	// The second Configure is served from the cache.
+	data, err := os.ReadFile(runs)
+	require.NoError(t, err)
+	require.Equal(t, 1, strings.Count(string(data), "run"))
+}
+
+func TestResolveTokenCommandErrors(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("the test commands need a POSIX shell")
+	}
+
+	tests := []struct {
+		name       string
+		command    string
+		wantDetail string
+	}{
+		{
+			name:       "empty output",
+			command:    "true",
+			wantDetail: "printed nothing on standard output",
+		},
+		{
+			name:       "failure",
+			command:    "echo 'vault is sealed' >&2; exit 2",
+			wantDetail: "vault is sealed",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("WARPSTREAM_API_KEY", "")
+			t.Setenv("WARPSTREAM_API_KEY_FILE", "")
+			t.Setenv("WARPSTREAM_API_KEY_COMMAND", tt.command)
+
+			var diags diag.Diagnostics
+			token := (&warpstreamProvider{}).resolveToken(t.Context(), warpstreamProviderModel{}, credentialsProfile{}, &diags)
+
+			require.True(t, diags.HasError())
+			require.Empty(t, token)
+			require.Contains(t, diags.Errors()[0].Detail(), tt.wantDetail)
+			require.Contains(t, diags.Errors()[0].Detail(), "WARPSTREAM_API_KEY_COMMAND")
+		})
+	}
+}
@@ -0,0 +1,65 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/warpstreamlabs/terraform-provider-warpstream/internal/provider/api"
+)
+
+// workspaceIDs returns the elements of the allowed_workspace_ids or forbidden_workspace_ids set.
+func workspaceIDs(ctx context.Context, set types.Set, attr string, diags *diag.Diagnostics) []string {
+	if set.IsNull() {
+		return nil
+	}
+	if set.IsUnknown() {
+		diags.AddAttributeError(path.Root(attr), "Unknown Workspace IDs",
+			fmt.Sprintf("The provider cannot check which workspace it manages as there is an unknown configuration value for %s. "+
+				"Either target apply the source of the value first, or set the value statically in the configuration.", attr))
+		return nil
+	}
+
+	var ids []string
+	diags.Append(set.ElementsAs(ctx, &ids, false)...)
+	return ids
+}
+
+// checkWorkspaces fails Configure when the API key can't reach any workspace that
+// allowed_workspace_ids and forbidden_workspace_ids allow. That catches a key for the wrong account
+// or workspace before anything is planned; resources then check their own workspace at plan time.
+func checkWorkspaces(ctx context.Context, client *api.Client, diags *diag.Diagnostics) {
+	if !client.GuardsWorkspaces() {
+		return
+	}
+
+	reachable, err := client.ReachableWorkspaceIDs(ctx)
+	if err != nil {
+		diags.AddError("Unable to Check WarpStream Workspaces",
+			"The provider could not list the workspaces of its API key to check them against allowed_workspace_ids "+
+				"and forbidden_workspace_ids: "+err.Error())
+		return
+	}
+	if len(reachable) == 0 {
+		diags.AddWarning("Unable to Check WarpStream Workspaces",
+			"The provider could not tell which workspace its API key belongs to, so allowed_workspace_ids and "+
+				"forbidden_workspace_ids are only checked against each resource's workspace at plan time.")
+		return
+	}
+
+	var reasons []string
+	for _, id := range reachable {
+		err := client.CheckWorkspaceID(id)
+		if err == nil {
+			return
+		}
+		reasons = append(reasons, err.Error())
+	}
+	diags.AddError("Workspace Not Allowed",
+		"The provider's API key can only manage workspaces that the provider configuration rules out:\n\n- "+
+			strings.Join(reasons, "\n- ")+"\n\nCheck that the API key is for the intended account and workspace.")
+}
@@ -0,0 +1,44 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/stretchr/testify/require"
+
+	"github.com/warpstreamlabs/terraform-provider-warpstream/internal/provider/api"
+	"github.com/warpstreamlabs/terraform-provider-warpstream/internal/provider/apitest/fakeserver"
+)
+
+func TestCheckWorkspaces(t *testing.T) {
+	server := fakeserver.New()
+	defer server.Close()
+
+	tests := []struct {
+		name      string
+		allowed   []string
+		forbidden []string
+		wantErr   bool
+	}{
+		{name: "no restrictions"},
+		{name: "key's workspace allowed", allowed: []string{server.DefaultWorkspaceID(), "wi_other"}},
+		{name: "key's workspace not allowed", allowed: []string{"wi_other"}, wantErr: true},
+		{name: "key's only workspace forbidden", forbidden: []string{server.DefaultWorkspaceID()}, wantErr: true},
+		{name: "other workspace forbidden", forbidden: []string{"wi_other"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			token := "aks_test"
+			client, err := api.NewClient(server.URL(), &token, "test", api.DefaultClientOptions())
+			require.NoError(t, err)
+			client.AllowedWorkspaceIDs = tt.allowed
+			client.ForbiddenWorkspaceIDs = tt.forbidden
+
+			var diags diag.Diagnostics
+			checkWorkspaces(t.Context(), client, &diags)
+
+			require.Equal(t, tt.wantErr, diags.HasError(), diags)
+		})
+	}
+}